@@ -0,0 +1,160 @@
+package bookmarks
+
+import (
+	"strings"
+
+	"github.com/charan-kumar-137/gsui/config"
+	"github.com/charan-kumar-137/gsui/keys"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	titleStyle    = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#3367D6"))
+	selectedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFFFF")).Background(lipgloss.Color("#3367D6"))
+	pathStyle     = lipgloss.NewStyle().Faint(true)
+	errorStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("#D93025"))
+	hintStyle     = lipgloss.NewStyle().Faint(true)
+
+	boxStyle = lipgloss.NewStyle().
+			Border(lipgloss.ThickBorder()).
+			BorderForeground(lipgloss.Color("#3367D6")).
+			Padding(0, 1)
+
+	// Most Bookmarks shown at once
+	maxVisible = 12
+)
+
+// Sent when a Bookmark is picked, to jump to its Path
+type SelectMsg struct {
+	Path string
+}
+
+// Sent when the Picker is closed without picking
+type CloseMsg struct{}
+
+// Picker of the saved Bookmarks, narrowed by typing
+type Model struct {
+	input     textinput.Model
+	bookmarks []config.Bookmark
+	// Bookmarks matching the input
+	matches []config.Bookmark
+	cursor  int
+	err     error
+	width   int
+	height  int
+}
+
+func New() Model {
+	ti := textinput.New()
+	ti.Prompt = "> "
+	ti.Placeholder = "bookmark name or path"
+
+	return Model{input: ti}
+}
+
+// Reload the Bookmarks and reset the input
+func (m *Model) Open() tea.Cmd {
+	m.bookmarks, m.err = config.LoadBookmarks()
+	m.input.SetValue("")
+	m.cursor = 0
+	m.match()
+
+	return m.input.Focus()
+}
+
+func (m *Model) SetDimension(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+func (m *Model) match() {
+	var query = strings.ToLower(m.input.Value())
+
+	m.matches = nil
+	for _, bookmark := range m.bookmarks {
+		if strings.Contains(strings.ToLower(bookmark.Name), query) || strings.Contains(strings.ToLower(bookmark.Path), query) {
+			m.matches = append(m.matches, bookmark)
+		}
+	}
+
+	m.cursor = max(min(m.cursor, len(m.matches)-1), 0)
+}
+
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(keyMsg, keys.Keys.Escape):
+		m.input.Blur()
+		return m, func() tea.Msg { return CloseMsg{} }
+	case key.Matches(keyMsg, keys.Keys.Up):
+		m.cursor = max(m.cursor-1, 0)
+		return m, nil
+	case key.Matches(keyMsg, keys.Keys.Down):
+		m.cursor = max(min(m.cursor+1, len(m.matches)-1), 0)
+		return m, nil
+	case key.Matches(keyMsg, keys.Keys.Submit):
+		if len(m.matches) == 0 {
+			return m, nil
+		}
+		var path = m.matches[m.cursor].Path
+		m.input.Blur()
+		return m, func() tea.Msg { return SelectMsg{Path: path} }
+	case key.Matches(keyMsg, keys.Keys.RemoveBookmark):
+		if len(m.matches) == 0 {
+			return m, nil
+		}
+		if m.err = config.RemoveBookmark(m.matches[m.cursor].Name); m.err == nil {
+			m.bookmarks, m.err = config.LoadBookmarks()
+			m.match()
+		}
+		return m, nil
+	}
+
+	m.input, cmd = m.input.Update(keyMsg)
+	m.match()
+
+	return m, cmd
+}
+
+func (m Model) View() string {
+	var sb strings.Builder
+
+	sb.WriteString(titleStyle.Render("Bookmarks") + "\n\n")
+	sb.WriteString(m.input.View() + "\n\n")
+
+	var start = max(m.cursor-maxVisible+1, 0)
+	for i := start; i < len(m.matches) && i < start+maxVisible; i++ {
+		var line = m.matches[i].Name + "  " + pathStyle.Render("gs://"+m.matches[i].Path)
+		if i == m.cursor {
+			line = selectedStyle.Render(m.matches[i].Name) + "  " + pathStyle.Render("gs://"+m.matches[i].Path)
+		}
+		sb.WriteString(line + "\n")
+	}
+
+	if len(m.bookmarks) == 0 {
+		sb.WriteString(hintStyle.Render("No bookmarks yet, press b in the list to add one") + "\n")
+	} else if len(m.matches) == 0 {
+		sb.WriteString(hintStyle.Render("No matching bookmarks") + "\n")
+	}
+
+	if m.err != nil {
+		sb.WriteString("\n" + errorStyle.Render(m.err.Error()) + "\n")
+	}
+
+	sb.WriteString("\n" + hintStyle.Render("enter: open • ctrl+d: remove • esc: close"))
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, boxStyle.Render(sb.String()))
+}
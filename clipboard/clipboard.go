@@ -0,0 +1,41 @@
+package clipboard
+
+import (
+	"os"
+	"strings"
+
+	atotto "github.com/atotto/clipboard"
+	"github.com/aymanbagabas/go-osc52/v2"
+)
+
+// Running over SSH, the local Clipboard is only reachable through the Terminal
+func isRemote() bool {
+	return len(os.Getenv("SSH_TTY")) != 0 || len(os.Getenv("SSH_CONNECTION")) != 0
+}
+
+// Ask the Terminal to set its Clipboard with an OSC52 escape sequence
+func copyOSC52(text string) error {
+	var seq = osc52.New(text)
+
+	if len(os.Getenv("TMUX")) != 0 {
+		seq = seq.Tmux()
+	} else if strings.HasPrefix(os.Getenv("TERM"), "screen") {
+		seq = seq.Screen()
+	}
+
+	_, err := seq.WriteTo(os.Stderr)
+	return err
+}
+
+// Copy text to the System Clipboard, falling back to OSC52 over SSH or without a Clipboard
+func Copy(text string) error {
+	if isRemote() || atotto.Unsupported {
+		return copyOSC52(text)
+	}
+
+	if err := atotto.WriteAll(text); err != nil {
+		return copyOSC52(text)
+	}
+
+	return nil
+}
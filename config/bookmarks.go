@@ -0,0 +1,96 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var (
+	bookmarksFile = "bookmarks.json"
+)
+
+// Named gs:// location, Path is given without the gs:// prefix
+type Bookmark struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+type bookmarks struct {
+	Bookmarks []Bookmark `json:"bookmarks"`
+}
+
+// Bookmarks sorted by Name
+func LoadBookmarks() ([]Bookmark, error) {
+	var b bookmarks
+	if err := Load(bookmarksFile, &b); err != nil {
+		return nil, fmt.Errorf("failed to read bookmarks: %w", err)
+	}
+
+	sort.Slice(b.Bookmarks, func(i, j int) bool {
+		return b.Bookmarks[i].Name < b.Bookmarks[j].Name
+	})
+
+	return b.Bookmarks, nil
+}
+
+func saveBookmarks(list []Bookmark) error {
+	if err := Save(bookmarksFile, bookmarks{Bookmarks: list}); err != nil {
+		return fmt.Errorf("failed to save bookmarks: %w", err)
+	}
+	return nil
+}
+
+func FindBookmark(name string) (Bookmark, bool, error) {
+	list, err := LoadBookmarks()
+	if err != nil {
+		return Bookmark{}, false, err
+	}
+
+	for _, bookmark := range list {
+		if bookmark.Name == name {
+			return bookmark, true, nil
+		}
+	}
+
+	return Bookmark{}, false, nil
+}
+
+// Add the Bookmark, replacing one with the same Name
+func AddBookmark(name, path string) error {
+	name = strings.TrimSpace(name)
+	if len(name) == 0 {
+		return fmt.Errorf("bookmark name is required")
+	}
+
+	list, err := LoadBookmarks()
+	if err != nil {
+		return err
+	}
+
+	var bookmark = Bookmark{Name: name, Path: strings.TrimPrefix(path, "gs://")}
+	for i := range list {
+		if list[i].Name == name {
+			list[i] = bookmark
+			return saveBookmarks(list)
+		}
+	}
+
+	return saveBookmarks(append(list, bookmark))
+}
+
+func RemoveBookmark(name string) error {
+	list, err := LoadBookmarks()
+	if err != nil {
+		return err
+	}
+
+	var kept []Bookmark
+	for _, bookmark := range list {
+		if bookmark.Name != name {
+			kept = append(kept, bookmark)
+		}
+	}
+
+	return saveBookmarks(kept)
+}
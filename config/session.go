@@ -0,0 +1,40 @@
+package config
+
+import "fmt"
+
+var (
+	sessionFile = "session.json"
+)
+
+// Where the user left off, restored on the next launch
+type Session struct {
+	// Listed Path, never a Search
+	Path   string `json:"path"`
+	Cursor int    `json:"cursor"`
+	Offset int    `json:"offset"`
+	// Focused pane, one of search, list or none
+	Active string `json:"active"`
+	// Column title the Listings are sorted by, empty for the listed order
+	SortColumn     string `json:"sort_column"`
+	SortDescending bool   `json:"sort_descending"`
+}
+
+// Last saved Session, false when there is none
+func LoadSession() (Session, bool, error) {
+	var session *Session
+	if err := Load(sessionFile, &session); err != nil {
+		return Session{}, false, fmt.Errorf("failed to read session: %w", err)
+	}
+	if session == nil {
+		return Session{}, false, nil
+	}
+
+	return *session, true, nil
+}
+
+func SaveSession(session Session) error {
+	if err := Save(sessionFile, session); err != nil {
+		return fmt.Errorf("failed to save session: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/charan-kumar-137/gsui/gcs"
+)
+
+var (
+	settingsFile = "settings.json"
+)
+
+// Display Settings chosen by the user
+type Settings struct {
+	Format gcs.Format `json:"format"`
+	// Titles of the Columns shown, the defaults when empty
+	BucketColumns []string `json:"bucket_columns,omitempty"`
+	ObjectColumns []string `json:"object_columns,omitempty"`
+}
+
+func LoadSettings() (Settings, error) {
+	var settings Settings
+	if err := Load(settingsFile, &settings); err != nil {
+		return Settings{}, fmt.Errorf("failed to read settings: %w", err)
+	}
+	return settings, nil
+}
+
+func SaveSettings(settings Settings) error {
+	if err := Save(settingsFile, settings); err != nil {
+		return fmt.Errorf("failed to save settings: %w", err)
+	}
+	return nil
+}
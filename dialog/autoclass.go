@@ -0,0 +1,44 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/charan-kumar-137/gsui/form"
+	"github.com/charan-kumar-137/gsui/gcs"
+	"github.com/charan-kumar-137/gsui/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Open the Autoclass Form for the selected Bucket
+func (m *Model) EditAutoclass(selected list.CurrentData) bool {
+	var bucket = selected.GetSelectedBucket()
+	if bucket == nil {
+		return false
+	}
+
+	var name = bucket.GetName()
+	var metageneration = bucket.GetMetageneration()
+	var current = bucket.GetAutoclass()
+
+	var fields = []form.Field{
+		form.NewOptionField("Autoclass", enabledOptions, enabledOption(current.Enabled)),
+		form.NewOptionField("Terminal Storage Class", withCurrent(gcs.TerminalStorageClasses, current.TerminalStorageClass), current.TerminalStorageClass),
+	}
+
+	var note = "Autoclass moves objects between storage classes based on access. The terminal class is the coldest class objects transition to."
+
+	m.setForm(form.New("Autoclass - "+name, note, fields, func(values []string) tea.Cmd {
+		var updated = gcs.Autoclass{Enabled: values[0] == enabledOptions[0], TerminalStorageClass: values[1]}
+		if updated == current || (!updated.Enabled && !current.Enabled) {
+			return result(ResultMsg{Text: "No changes to " + name})
+		}
+		return func() tea.Msg {
+			if err := gcs.UpdateAutoclass(name, metageneration, updated); err != nil {
+				return ResultMsg{Err: err}
+			}
+			return ResultMsg{Text: fmt.Sprintf("Autoclass of %s: %s", name, enabledOption(updated.Enabled)), Refresh: true}
+		}
+	}))
+
+	return true
+}
@@ -0,0 +1,47 @@
+package dialog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charan-kumar-137/gsui/config"
+	"github.com/charan-kumar-137/gsui/form"
+	"github.com/charan-kumar-137/gsui/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Open the Form naming a Bookmark of the Current Path, or of the selected Bucket
+func (m *Model) AddBookmark(selected list.CurrentData) bool {
+	var path = selected.GetPath()
+	if bucket := selected.GetSelectedBucket(); len(path) == 0 && bucket != nil {
+		path = bucket.GetName()
+	}
+	if len(path) == 0 {
+		return false
+	}
+
+	var trimmed = strings.TrimSuffix(path, "/")
+	var name = trimmed[strings.LastIndex(trimmed, "/")+1:]
+
+	var fields = []form.Field{
+		form.NewField("Name", name).WithValidate(func(value string) error {
+			if len(strings.TrimSpace(value)) == 0 {
+				return fmt.Errorf("bookmark name is required")
+			}
+			return nil
+		}),
+		form.NewField("Path", path).WithPlaceholder("bucket/prefix/"),
+	}
+
+	m.setForm(form.New("Add Bookmark", "A Bookmark with the same name is replaced.", fields, func(values []string) tea.Cmd {
+		var name, path = strings.TrimSpace(values[0]), strings.TrimSpace(values[1])
+		return func() tea.Msg {
+			if err := config.AddBookmark(name, path); err != nil {
+				return ResultMsg{Err: err}
+			}
+			return ResultMsg{Text: fmt.Sprintf("Bookmarked gs://%s as %s", strings.TrimPrefix(path, "gs://"), name)}
+		}
+	}))
+
+	return true
+}
@@ -0,0 +1,76 @@
+package dialog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charan-kumar-137/gsui/keys"
+	"github.com/charan-kumar-137/gsui/list"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Location of a Bucket or Object in its different forms
+type locator interface {
+	GetURI() string
+	GetAuthenticatedURL() string
+	GetPublicURL() string
+}
+
+// Marked Buckets or Objects, else the selected row
+func copyTargets(selected list.CurrentData) []locator {
+	var targets []locator
+	for _, object := range selected.GetMarkedObjects() {
+		targets = append(targets, object)
+	}
+	for _, bucket := range selected.GetMarkedBuckets() {
+		targets = append(targets, bucket)
+	}
+	if len(targets) != 0 {
+		return targets
+	}
+
+	if object := selected.GetSelectedObject(); object != nil {
+		return []locator{object}
+	} else if bucket := selected.GetSelectedBucket(); bucket != nil {
+		return []locator{bucket}
+	}
+	return nil
+}
+
+// Copy the URI or URL of the marked or selected rows matching the key, one per line.
+// nil if the key is not a copy key.
+func (m *Model) CopyURL(msg tea.KeyMsg, selected list.CurrentData) tea.Cmd {
+	var targets = copyTargets(selected)
+	if len(targets) == 0 {
+		return nil
+	}
+
+	var what string
+	var location func(locator) string
+
+	switch {
+	case key.Matches(msg, keys.Keys.CopyURI):
+		what, location = "URI", locator.GetURI
+	case key.Matches(msg, keys.Keys.CopyAuthenticatedURL):
+		what, location = "authenticated URL", locator.GetAuthenticatedURL
+	case key.Matches(msg, keys.Keys.CopyPublicURL):
+		what, location = "public URL", locator.GetPublicURL
+	default:
+		return nil
+	}
+
+	if len(targets) == 1 {
+		// A single URI names itself in the status
+		if what == "URI" {
+			what = targets[0].GetURI()
+		}
+		return copyToClipboard(what, location(targets[0]))
+	}
+
+	var lines []string
+	for _, target := range targets {
+		lines = append(lines, location(target))
+	}
+	return copyToClipboard(fmt.Sprintf("%d %ss", len(targets), what), strings.Join(lines, "\n"))
+}
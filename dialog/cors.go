@@ -0,0 +1,129 @@
+package dialog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charan-kumar-137/gsui/form"
+	"github.com/charan-kumar-137/gsui/gcs"
+	"github.com/charan-kumar-137/gsui/list"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var (
+	corsCols = []table.Column{
+		{Title: "Origins", Width: 25},
+		{Title: "Methods", Width: 15},
+		{Title: "Response Headers", Width: 20},
+		{Title: "Max Age", Width: 8},
+	}
+
+	corsJSONHeight = 12
+)
+
+func corsStrings(entries []gcs.CORSEntry) []string {
+	var lines []string
+	for _, entry := range entries {
+		lines = append(lines, entry.String())
+	}
+	return lines
+}
+
+func corsEntryForm(entry gcs.CORSEntry, save func(gcs.CORSEntry)) form.Model {
+	var maxAge = ""
+	if entry.MaxAgeSeconds != 0 {
+		maxAge = fmt.Sprint(entry.MaxAgeSeconds)
+	}
+
+	var fields = []form.Field{
+		form.NewField("Origins", strings.Join(entry.Origins, ",")).WithPlaceholder("https://example.com,*"),
+		form.NewField("Methods", strings.Join(entry.Methods, ",")).WithPlaceholder("GET,HEAD"),
+		form.NewField("Response Headers", strings.Join(entry.ResponseHeaders, ",")).WithPlaceholder("Content-Type"),
+		form.NewField("Max Age (seconds)", maxAge).WithValidate(validateNumber),
+	}
+
+	return form.New("CORS Entry", "Separate multiple values with commas.", fields, func(values []string) tea.Cmd {
+		var updated = gcs.CORSEntry{
+			Origins:         splitList(values[0]),
+			Methods:         splitList(strings.ToUpper(values[1])),
+			ResponseHeaders: splitList(values[2]),
+			MaxAgeSeconds:   parseNumber(values[3]),
+		}
+
+		if err := updated.Validate(); err != nil {
+			return result(ResultMsg{Err: err})
+		}
+		save(updated)
+		return edited()
+	})
+}
+
+// Open the CORS Editor for the selected Bucket
+func (m *Model) EditCORS(selected list.CurrentData) bool {
+	var bucket = selected.GetSelectedBucket()
+	if bucket == nil {
+		return false
+	}
+
+	var name = bucket.GetName()
+	var metageneration = bucket.GetMetageneration()
+	var old = bucket.GetCORS()
+	var entries = append([]gcs.CORSEntry{}, old...)
+
+	var e = newEditor("CORS - "+name, corsCols, func() []table.Row {
+		var rows []table.Row
+		for _, entry := range entries {
+			rows = append(rows, table.Row{
+				strings.Join(entry.Origins, ","),
+				strings.Join(entry.Methods, ","),
+				strings.Join(entry.ResponseHeaders, ","),
+				fmt.Sprint(entry.MaxAgeSeconds),
+			})
+		}
+		return rows
+	})
+
+	e.add = func() form.Model {
+		return corsEntryForm(gcs.CORSEntry{}, func(entry gcs.CORSEntry) {
+			entries = append(entries, entry)
+		})
+	}
+	e.edit = func(index int) form.Model {
+		return corsEntryForm(entries[index], func(entry gcs.CORSEntry) {
+			entries[index] = entry
+		})
+	}
+	e.remove = func(index int) {
+		entries = append(entries[:index], entries[index+1:]...)
+	}
+	e.raw = func() form.Model {
+		var fields = []form.Field{
+			form.NewTextAreaField("CORS JSON", gcs.CORSToJSON(entries), corsJSONHeight).
+				WithValidate(func(value string) error {
+					_, err := gcs.ParseCORSJSON(value)
+					return err
+				}),
+		}
+		return form.New("CORS JSON - "+name, "", fields, func(values []string) tea.Cmd {
+			entries, _ = gcs.ParseCORSJSON(values[0])
+			return edited()
+		})
+	}
+	e.diff = func() []string {
+		return diffLines(corsStrings(old), corsStrings(entries))
+	}
+	e.apply = func() tea.Cmd {
+		var update = append([]gcs.CORSEntry{}, entries...)
+		return func() tea.Msg {
+			if err := gcs.UpdateCORS(name, metageneration, update); err != nil {
+				return ResultMsg{Err: err}
+			}
+			return ResultMsg{Text: fmt.Sprintf("Updated CORS of %s: %d entries", name, len(update)), Refresh: true}
+		}
+	}
+
+	m.setEditor(e)
+
+	return true
+}
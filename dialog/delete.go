@@ -0,0 +1,86 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/charan-kumar-137/gsui/form"
+	"github.com/charan-kumar-137/gsui/gcs"
+	"github.com/charan-kumar-137/gsui/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Open the Delete Form for the selected Bucket, or the marked or selected Objects
+func (m *Model) Delete(selected list.CurrentData) bool {
+	if selected.GetSelectedBucket() != nil {
+		return m.DeleteBucket(selected)
+	}
+	return m.DeleteObjects(selected)
+}
+
+// Open the Delete Bucket Form for the selected Bucket
+func (m *Model) DeleteBucket(selected list.CurrentData) bool {
+	var bucket = selected.GetSelectedBucket()
+	if bucket == nil {
+		return false
+	}
+
+	var name = bucket.GetName()
+
+	// Counting lists every Version, which takes a while on large Buckets
+	m.load("Delete Bucket", "Counting objects in gs://"+name+"...", func() (func(*Model), error) {
+		count, err := gcs.CountObjects(name)
+		if err != nil {
+			return nil, err
+		}
+		return func(m *Model) {
+			m.setForm(deleteBucketForm(name, count))
+		}, nil
+	})
+
+	return true
+}
+
+func deleteBucketForm(name string, count gcs.ObjectCount) form.Model {
+	var note = fmt.Sprintf("gs://%s is empty.", name)
+	var fields []form.Field
+
+	if count.Total() != 0 {
+		note = fmt.Sprintf("gs://%s contains %d objects (%d live, %d noncurrent versions).",
+			name, count.Total(), count.Live, count.Noncurrent)
+		fields = append(fields, form.NewOptionField("Empty bucket first (all versions)", []string{"Yes", "No"}, "Yes"))
+	}
+
+	fields = append(fields, form.NewField("Type the bucket name to confirm", "").
+		WithPlaceholder(name).
+		WithValidate(func(value string) error {
+			if value != name {
+				return fmt.Errorf("bucket name does not match %q", name)
+			}
+			return nil
+		}))
+
+	return form.New("Delete Bucket", note, fields, func(values []string) tea.Cmd {
+		var empty = count.Total() != 0 && values[0] == "Yes"
+		return deleteBucket(name, empty)
+	})
+}
+
+func deleteBucket(name string, empty bool) tea.Cmd {
+	return func() tea.Msg {
+		var deleted = 0
+		var err error
+
+		if empty {
+			deleted, err = gcs.EmptyBucket(name)
+			if err != nil {
+				return ResultMsg{Err: fmt.Errorf("emptied %d objects, then failed: %w", deleted, err)}
+			}
+		}
+
+		if err = gcs.DeleteBucket(name); err != nil {
+			return ResultMsg{Err: err}
+		}
+
+		return ResultMsg{Text: fmt.Sprintf("Deleted bucket %s (%d objects removed)", name, deleted), Refresh: true}
+	}
+}
@@ -1,13 +1,108 @@
 package dialog
 
 import (
+	"strings"
+
+	"github.com/charan-kumar-137/gsui/form"
+	"github.com/charan-kumar-137/gsui/keys"
 	"github.com/charan-kumar-137/gsui/list"
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
+var (
+	statusStyle = lipgloss.NewStyle().Italic(true).Foreground(lipgloss.Color("#3367D6"))
+	helpStyle   = lipgloss.NewStyle().Faint(true)
+
+	// Settings Panels offered for the selected Bucket
+	bucketActions = []key.Binding{
+		keys.Keys.EditBucket,
+		keys.Keys.Lifecycle,
+		keys.Keys.IAM,
+		keys.Keys.CORS,
+		keys.Keys.Retention,
+		keys.Keys.Website,
+		keys.Keys.Logging,
+		keys.Keys.Notifications,
+		keys.Keys.Autoclass,
+		keys.Keys.Delete,
+		keys.Keys.CopyURI,
+		keys.Keys.Bookmark,
+	}
+
+	// Actions offered for the selected Object, batch Actions act on the marked Objects
+	objectActions = []key.Binding{
+		keys.Keys.IAM,
+		keys.Keys.SignURL,
+		keys.Keys.Download,
+		keys.Keys.Delete,
+		keys.Keys.StorageClass,
+		keys.Keys.CopyURI,
+		keys.Keys.CopyAuthenticatedURL,
+		keys.Keys.CopyPublicURL,
+		keys.Keys.Bookmark,
+	}
+)
+
+func actionsHelp(bindings []key.Binding) string {
+	var sb strings.Builder
+	for _, binding := range bindings {
+		sb.WriteString(binding.Help().Key + " " + binding.Help().Desc + "\n")
+	}
+	return helpStyle.Render(sb.String())
+}
+
+// Result of an Action started from the Dialog
+type ResultMsg struct {
+	Text    string
+	Err     error
+	Refresh bool
+}
+
+// Sent once the active Form is closed
+type ClosedMsg struct {
+	Refresh bool
+}
+
+// Replace the active Form, used for multi step Actions
+type openFormMsg struct {
+	form form.Model
+}
+
+func openForm(f form.Model) tea.Cmd {
+	return func() tea.Msg {
+		return openFormMsg{form: f}
+	}
+}
+
+// Content fetched for an Action, opening its Form or Editor
+type loadedMsg struct {
+	tag  int
+	open func(*Model)
+	err  error
+}
+
+func result(msg ResultMsg) tea.Cmd {
+	return func() tea.Msg {
+		return msg
+	}
+}
+
+func closed(refresh bool) tea.Cmd {
+	return func() tea.Msg {
+		return ClosedMsg{Refresh: refresh}
+	}
+}
+
 type Model struct {
-	text    string
+	text   string
+	status string
+	form   *form.Model
+	editor *editor
+	// Fetch started by the last opened Action, tag drops results of cancelled ones
+	started tea.Cmd
+	tag     int
 	focused bool
 	width   int
 	height  int
@@ -29,9 +124,48 @@ func (m Model) GetFocus() bool {
 	return m.focused
 }
 
+// Whether an Action Form or Editor is open in the Dialog
+func (m Model) HasForm() bool {
+	return m.form != nil || m.editor != nil
+}
+
+func (m *Model) setEditor(e *editor) {
+	m.editor = e
+	m.form = nil
+	m.status = ""
+}
+
+func (m *Model) setForm(f form.Model) {
+	f.SetDimension(m.width, m.height)
+	m.form = &f
+	m.status = ""
+}
+
+// Show a loading Form while fetch runs off the Update loop, then open what it returns
+func (m *Model) load(title, note string, fetch func() (func(*Model), error)) {
+	m.tag += 1
+	var tag = m.tag
+
+	m.setForm(form.NewLoading(title, note))
+	m.started = func() tea.Msg {
+		open, err := fetch()
+		return loadedMsg{tag: tag, open: open, err: err}
+	}
+}
+
+// Cmd started by the last opened Action, cleared once taken
+func (m *Model) StartedCmd() tea.Cmd {
+	var cmd = m.started
+	m.started = nil
+	return cmd
+}
+
 func (m *Model) SetDimension(width, height int) {
 	m.width = width
 	m.height = height
+	if m.form != nil {
+		m.form.SetDimension(width, height)
+	}
 }
 
 func (m Model) Init() tea.Cmd {
@@ -47,14 +181,14 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			if data.IsBucket {
 				var bucket = data.GetBucket(msg.GetCurrentCursor())
 				if bucket != nil {
-					m.text = bucket.DisplayString()
+					m.text = bucket.DisplayString() + "\n" + actionsHelp(bucketActions)
 				} else {
 					m.text = "Not Found Bucket " + msg.GetPath()
 				}
 			} else {
 				var object = data.GetObject(msg.GetCurrentCursor())
 				if object != nil {
-					m.text = object.DisplayString()
+					m.text = object.DisplayString() + "\n" + actionsHelp(objectActions)
 				} else {
 					m.text = "Not Found Object " + msg.GetPath()
 				}
@@ -62,11 +196,79 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		} else {
 			m.text = "Not Found Data " + msg.GetPath()
 		}
+		return m, nil
+	case openFormMsg:
+		m.setForm(msg.form)
+		return m, nil
+	case settingsSavedMsg:
+		return m, result(msg.apply())
+	case loadedMsg:
+		// The loading Form was cancelled or replaced meanwhile
+		if msg.tag != m.tag || m.form == nil || !m.form.IsLoading() {
+			return m, nil
+		}
+		if msg.err != nil {
+			m.form.SetError(msg.err)
+			return m, nil
+		}
+		m.form = nil
+		msg.open(&m)
+		return m, nil
+	case editedMsg:
+		m.form = nil
+		if m.editor != nil {
+			m.editor.refresh()
+		}
+		return m, nil
+	case form.CancelMsg:
+		// Cancelling a Form opened from an Editor returns to the Editor
+		if m.form != nil && m.editor != nil {
+			m.form = nil
+			return m, nil
+		}
+		m.form = nil
+		m.editor = nil
+		return m, closed(false)
+	case ResultMsg:
+		if msg.Err != nil {
+			if m.form != nil {
+				m.form.SetError(msg.Err)
+				return m, nil
+			}
+			m.status = msg.Err.Error()
+			return m, nil
+		}
+		m.form = nil
+		m.editor = nil
+		m.status = msg.Text
+		return m, closed(msg.Refresh)
+	}
+
+	var cmd tea.Cmd
+
+	if m.form != nil && m.GetFocus() {
+		var f form.Model
+		f, cmd = m.form.Update(msg)
+		m.form = &f
+	} else if m.editor != nil && m.GetFocus() {
+		cmd = m.editor.Update(msg)
 	}
 
-	return m, nil
+	return m, cmd
 }
 
 func (m Model) View() string {
+	if m.form != nil {
+		return m.form.View()
+	}
+
+	if m.editor != nil {
+		return m.editor.View()
+	}
+
+	if len(m.status) != 0 {
+		return lipgloss.NewStyle().Render(statusStyle.Render(m.status) + "\n\n" + m.text)
+	}
+
 	return lipgloss.NewStyle().Render(m.text)
 }
@@ -0,0 +1,191 @@
+package dialog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charan-kumar-137/gsui/form"
+	"github.com/charan-kumar-137/gsui/keys"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	editorTitleStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#3367D6"))
+	editorHintStyle  = lipgloss.NewStyle().Faint(true)
+
+	editorTableHeight = 10
+)
+
+// Sent when an Entry was added or edited, returning to the Editor
+type editedMsg struct{}
+
+func edited() tea.Cmd {
+	return func() tea.Msg {
+		return editedMsg{}
+	}
+}
+
+// Editor for a list of Entries (Rules, Bindings, ...) applied together after reviewing a Diff
+type editor struct {
+	title  string
+	table  table.Model
+	status string
+	rows   func() []table.Row
+	add    func() form.Model
+	edit   func(index int) form.Model
+	remove func(index int)
+	// Optional, edit all Entries at once as JSON
+	raw   func() form.Model
+	diff  func() []string
+	apply func() tea.Cmd
+}
+
+func newEditor(title string, columns []table.Column, rows func() []table.Row) *editor {
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows()),
+		table.WithHeight(editorTableHeight),
+		table.WithFocused(true),
+		table.WithKeyMap(table.KeyMap{
+			LineUp:   keys.Keys.Up,
+			LineDown: keys.Keys.Down,
+			PageUp:   keys.Keys.PageUp,
+			PageDown: keys.Keys.PageDown,
+		}),
+	)
+
+	s := table.DefaultStyles()
+	s.Header = s.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderBottom(true)
+
+	s.Selected = s.Selected.
+		Foreground(lipgloss.Color("#FFFFFF")).
+		Background(lipgloss.Color("#3367D6"))
+
+	t.SetStyles(s)
+
+	return &editor{title: title, table: t, rows: rows}
+}
+
+// Reload the Rows after the Entries changed
+func (e *editor) refresh() {
+	var rows = e.rows()
+	e.table.SetRows(rows)
+	if e.table.Cursor() >= len(rows) {
+		e.table.SetCursor(len(rows) - 1)
+	}
+}
+
+func (e *editor) selected() (int, bool) {
+	var cursor = e.table.Cursor()
+	return cursor, cursor >= 0 && cursor < len(e.table.Rows())
+}
+
+func (e *editor) Update(msg tea.Msg) tea.Cmd {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		e.status = ""
+		switch {
+		case key.Matches(msg, keys.Keys.Escape):
+			return func() tea.Msg { return form.CancelMsg{} }
+		case key.Matches(msg, keys.Keys.Add):
+			if e.add != nil {
+				return openForm(e.add())
+			}
+			return nil
+		case key.Matches(msg, keys.Keys.Edit):
+			if index, ok := e.selected(); ok && e.edit != nil {
+				return openForm(e.edit(index))
+			}
+			return nil
+		case key.Matches(msg, keys.Keys.Remove):
+			if index, ok := e.selected(); ok && e.remove != nil {
+				e.remove(index)
+				e.refresh()
+			}
+			return nil
+		case key.Matches(msg, keys.Keys.RawJSON):
+			if e.raw != nil {
+				return openForm(e.raw())
+			}
+			return nil
+		case key.Matches(msg, keys.Keys.Apply):
+			var changes = e.diff()
+			if len(changes) == 0 {
+				e.status = "No changes to apply"
+				return nil
+			}
+			var note = "Apply the following changes?\n\n" + strings.Join(changes, "\n")
+			return openForm(form.New(e.title, note, nil, func(_ []string) tea.Cmd {
+				return e.apply()
+			}))
+		}
+	}
+
+	e.table, cmd = e.table.Update(msg)
+
+	return cmd
+}
+
+func (e *editor) View() string {
+	var sb strings.Builder
+
+	sb.WriteString(editorTitleStyle.Render(e.title) + "\n\n")
+	sb.WriteString(e.table.View() + "\n\n")
+
+	var changes = len(e.diff())
+	if len(e.status) != 0 {
+		sb.WriteString(e.status + "\n")
+	} else if changes != 0 {
+		sb.WriteString(fmt.Sprintf("%d pending changes\n", changes))
+	}
+
+	var hints []string
+	if e.add != nil {
+		hints = append(hints, "a: add")
+	}
+	if e.edit != nil {
+		hints = append(hints, "e: edit")
+	}
+	if e.remove != nil {
+		hints = append(hints, "d: remove")
+	}
+	if e.raw != nil {
+		hints = append(hints, "j: json")
+	}
+	var hint = strings.Join(append(hints, "s: apply", "esc: close"), " • ")
+	sb.WriteString(editorHintStyle.Render(hint) + "\n")
+
+	return lipgloss.NewStyle().Render(sb.String())
+}
+
+// Lines removed from old are prefixed with "-", lines added in new with "+"
+func diffLines(old, new []string) []string {
+	var changes []string
+	var remaining = make(map[string]int)
+
+	for _, line := range new {
+		remaining[line] += 1
+	}
+	for _, line := range old {
+		if remaining[line] > 0 {
+			remaining[line] -= 1
+		} else {
+			changes = append(changes, "- "+line)
+		}
+	}
+	for _, line := range new {
+		if remaining[line] > 0 {
+			remaining[line] -= 1
+			changes = append(changes, "+ "+line)
+		}
+	}
+
+	return changes
+}
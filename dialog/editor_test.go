@@ -0,0 +1,27 @@
+package dialog
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDiffLines(t *testing.T) {
+	var tests = []struct {
+		name     string
+		old, new []string
+		want     []string
+	}{
+		{"unchanged", []string{"a", "b"}, []string{"a", "b"}, nil},
+		{"reordered", []string{"a", "b"}, []string{"b", "a"}, nil},
+		{"added", []string{"a"}, []string{"a", "b"}, []string{"+ b"}},
+		{"removed", []string{"a", "b"}, nil, []string{"- a", "- b"}},
+		{"replaced", []string{"a", "b"}, []string{"a", "c"}, []string{"- b", "+ c"}},
+		{"duplicates counted", []string{"a", "a"}, []string{"a"}, []string{"- a"}},
+	}
+
+	for _, test := range tests {
+		if got := diffLines(test.old, test.new); !slices.Equal(got, test.want) {
+			t.Errorf("%s: diffLines() = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
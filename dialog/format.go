@@ -0,0 +1,122 @@
+package dialog
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charan-kumar-137/gsui/config"
+	"github.com/charan-kumar-137/gsui/form"
+	"github.com/charan-kumar-137/gsui/gcs"
+	"github.com/charan-kumar-137/gsui/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var (
+	sizeUnitOptions = []string{gcs.SizeBytes, gcs.SizeSI, gcs.SizeIEC}
+	timeOptions     = []string{"absolute", "relative"}
+	zoneOptions     = []string{"local", "utc"}
+)
+
+// Layouts must tell one time from another
+func validateLayout(layout string) error {
+	var sample = time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+	if len(strings.TrimSpace(layout)) != 0 && sample.Format(layout) == layout {
+		return fmt.Errorf("layout %q has no time fields, see https://pkg.go.dev/time#Layout", layout)
+	}
+	return nil
+}
+
+// Comma separated Column titles, as typed in the Form
+func splitColumns(value string) []string {
+	var titles []string
+	for _, title := range strings.Split(value, ",") {
+		if title = strings.TrimSpace(title); len(title) != 0 {
+			titles = append(titles, title)
+		}
+	}
+	return titles
+}
+
+func validateBucketColumns(value string) error {
+	_, err := gcs.ResolveBucketColumns(splitColumns(value))
+	return err
+}
+
+func validateObjectColumns(value string) error {
+	_, err := gcs.ResolveObjectColumns(splitColumns(value))
+	return err
+}
+
+// Open the Display Settings Form, applied to every Listing and Detail
+func (m *Model) EditDisplaySettings(_ list.CurrentData) bool {
+	var current = gcs.GetFormat()
+	var bucketColumns, objectColumns = gcs.GetColumns()
+
+	var sizeUnits = current.SizeUnits
+	if len(sizeUnits) == 0 {
+		sizeUnits = gcs.SizeBytes
+	}
+
+	var fields = []form.Field{
+		form.NewOptionField("Size Units", sizeUnitOptions, sizeUnits),
+		form.NewOptionField("Times", timeOptions, pickOption(current.Relative, timeOptions)),
+		form.NewOptionField("Time Zone", zoneOptions, pickOption(current.UTC, zoneOptions)),
+		form.NewField("Time Layout", current.Layout).WithPlaceholder(time.DateTime).WithValidate(validateLayout),
+		form.NewField("Bucket Columns", strings.Join(bucketColumns, ", ")).
+			WithPlaceholder(strings.Join(gcs.DefaultBucketColumns, ", ")).WithValidate(validateBucketColumns),
+		form.NewField("Object Columns", strings.Join(objectColumns, ", ")).
+			WithPlaceholder(strings.Join(gcs.DefaultObjectColumns, ", ")).WithValidate(validateObjectColumns),
+	}
+
+	var note = "Absolute times use the layout, a Go reference time such as " + time.RFC1123 + ".\n" +
+		"Bucket columns: " + strings.Join(gcs.BucketColumns(), ", ") + ".\n" +
+		"Object columns: " + strings.Join(gcs.ObjectColumns(), ", ") + " or " + gcs.MetadataColumnPrefix + "<key>."
+
+	m.setForm(form.New("Display Settings", note, fields, func(values []string) tea.Cmd {
+		var updated = gcs.Format{
+			SizeUnits: values[0],
+			Relative:  values[1] == timeOptions[1],
+			UTC:       values[2] == zoneOptions[1],
+			Layout:    strings.TrimSpace(values[3]),
+		}
+		var buckets, objects = splitColumns(values[4]), splitColumns(values[5])
+		return func() tea.Msg {
+			settings, err := config.LoadSettings()
+			if err != nil {
+				return ResultMsg{Err: err}
+			}
+			settings.Format = updated
+			settings.BucketColumns, settings.ObjectColumns = buckets, objects
+			if err := config.SaveSettings(settings); err != nil {
+				return ResultMsg{Err: err}
+			}
+			return settingsSavedMsg{format: updated, buckets: buckets, objects: objects}
+		}
+	}))
+
+	return true
+}
+
+// Display Settings saved, applied on the update loop that presents them
+type settingsSavedMsg struct {
+	format  gcs.Format
+	buckets []string
+	objects []string
+}
+
+func (msg settingsSavedMsg) apply() ResultMsg {
+	gcs.SetFormat(msg.format)
+	if err := gcs.SetColumns(msg.buckets, msg.objects); err != nil {
+		return ResultMsg{Err: err}
+	}
+	return ResultMsg{Text: "Saved display settings", Refresh: true}
+}
+
+// Second of two options when set, else the first
+func pickOption(set bool, options []string) string {
+	if set {
+		return options[1]
+	}
+	return options[0]
+}
@@ -0,0 +1,242 @@
+package dialog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charan-kumar-137/gsui/form"
+	"github.com/charan-kumar-137/gsui/gcs"
+	"github.com/charan-kumar-137/gsui/list"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var (
+	iamCols = []table.Column{
+		{Title: "Role", Width: 30},
+		{Title: "Member", Width: 35},
+		{Title: "Condition", Width: 15},
+	}
+
+	aclCols = []table.Column{
+		{Title: "Entity", Width: 40},
+		{Title: "Role", Width: 10},
+	}
+)
+
+func bindingStrings(bindings []gcs.IAMBinding) []string {
+	var lines []string
+	for _, binding := range bindings {
+		lines = append(lines, binding.String())
+	}
+	return lines
+}
+
+func iamBindingForm(binding gcs.IAMBinding, save func(gcs.IAMBinding)) form.Model {
+	var fields = []form.Field{
+		form.NewField("Role", binding.Role).WithPlaceholder("roles/storage.objectViewer"),
+		form.NewField("Member", binding.Member).WithPlaceholder("user:name@example.com"),
+		form.NewField("Condition Title", binding.Title),
+		form.NewField("Condition Expression", binding.Condition).WithPlaceholder(`resource.name.startsWith("projects/_/buckets/b/objects/tmp/")`),
+	}
+
+	return form.New("IAM Binding", "Leave the condition empty for an unconditional binding.", fields, func(values []string) tea.Cmd {
+		var updated = binding
+		updated.Role = strings.TrimSpace(values[0])
+		updated.Member = strings.TrimSpace(values[1])
+		updated.Title = strings.TrimSpace(values[2])
+		updated.Condition = strings.TrimSpace(values[3])
+
+		if err := updated.Validate(); err != nil {
+			return result(ResultMsg{Err: err})
+		}
+		save(updated)
+		return edited()
+	})
+}
+
+// Open the IAM Editor for the selected Bucket, or the ACL Editor for an Object of a fine grained Bucket
+func (m *Model) EditIAM(selected list.CurrentData) bool {
+	if object := selected.GetSelectedObject(); object != nil {
+		return m.editObjectACL(selected, object)
+	}
+
+	var bucket = selected.GetSelectedBucket()
+	if bucket == nil {
+		return false
+	}
+
+	var name = bucket.GetName()
+
+	m.load("IAM Policy - "+name, "Loading the IAM policy...", func() (func(*Model), error) {
+		policy, err := gcs.GetIAMPolicy(name)
+		if err != nil {
+			return nil, err
+		}
+		return func(m *Model) {
+			m.setEditor(iamEditor(name, policy))
+		}, nil
+	})
+
+	return true
+}
+
+func iamEditor(name string, policy *gcs.IAMPolicy) *editor {
+	var old = policy.Bindings
+	var bindings = append([]gcs.IAMBinding{}, old...)
+
+	var e = newEditor("IAM Policy - "+name, iamCols, func() []table.Row {
+		var rows []table.Row
+		var previousRole = ""
+		for _, binding := range bindings {
+			// Show each Role once for its group of Members
+			var role = binding.Role
+			if role == previousRole {
+				role = ""
+			}
+			previousRole = binding.Role
+			rows = append(rows, table.Row{role, binding.Member, binding.Title})
+		}
+		return rows
+	})
+
+	e.add = func() form.Model {
+		var binding gcs.IAMBinding
+		if index, ok := e.selected(); ok {
+			binding.Role = bindings[index].Role
+		}
+		return iamBindingForm(binding, func(binding gcs.IAMBinding) {
+			// Keep Members grouped by Role
+			var at = len(bindings)
+			for i := len(bindings) - 1; i >= 0; i-- {
+				if bindings[i].Role == binding.Role {
+					at = i + 1
+					break
+				}
+			}
+			bindings = append(bindings[:at], append([]gcs.IAMBinding{binding}, bindings[at:]...)...)
+		})
+	}
+	e.edit = func(index int) form.Model {
+		return iamBindingForm(bindings[index], func(binding gcs.IAMBinding) {
+			bindings[index] = binding
+		})
+	}
+	e.remove = func(index int) {
+		bindings = append(bindings[:index], bindings[index+1:]...)
+	}
+	e.diff = func() []string {
+		return diffLines(bindingStrings(old), bindingStrings(bindings))
+	}
+	e.apply = func() tea.Cmd {
+		var update = append([]gcs.IAMBinding{}, bindings...)
+		return func() tea.Msg {
+			if err := gcs.SetIAMPolicy(name, policy, update); err != nil {
+				return ResultMsg{Err: err}
+			}
+			return ResultMsg{Text: fmt.Sprintf("Updated IAM policy of %s: %d bindings", name, len(update))}
+		}
+	}
+
+	return e
+}
+
+func aclRuleForm(rule gcs.ACLRule, save func(gcs.ACLRule)) form.Model {
+	var role = rule.Role
+	if len(role) == 0 {
+		role = gcs.ObjectACLRoles[0]
+	}
+
+	var fields = []form.Field{
+		form.NewField("Entity", rule.Entity).WithPlaceholder("user-name@example.com, group-..., allUsers").
+			WithValidate(func(value string) error {
+				if len(strings.TrimSpace(value)) == 0 {
+					return fmt.Errorf("entity is required")
+				}
+				return nil
+			}),
+		form.NewOptionField("Role", gcs.ObjectACLRoles, role),
+	}
+
+	return form.New("Object ACL Entry", "", fields, func(values []string) tea.Cmd {
+		save(gcs.ACLRule{Entity: strings.TrimSpace(values[0]), Role: values[1]})
+		return edited()
+	})
+}
+
+func (m *Model) editObjectACL(selected list.CurrentData, object *gcs.Object) bool {
+	var bucket = selected.GetCurrentData().GetParentBucket()
+	if bucket != nil && bucket.IsUniformAccess() {
+		m.status = "Uniform access: permissions of " + object.GetName() + " are managed by the bucket IAM policy"
+		return false
+	}
+
+	var bucketName = object.GetBucketName()
+	var name = object.GetName()
+
+	m.load("Object ACL - "+name, "Loading the object ACL...", func() (func(*Model), error) {
+		old, err := gcs.GetObjectACL(bucketName, name)
+		if err != nil {
+			return nil, err
+		}
+		return func(m *Model) {
+			m.setEditor(aclEditor(bucketName, name, old))
+		}, nil
+	})
+
+	return true
+}
+
+func aclEditor(bucketName, name string, old []gcs.ACLRule) *editor {
+	var rules = append([]gcs.ACLRule{}, old...)
+
+	var aclStrings = func(rules []gcs.ACLRule) []string {
+		var lines []string
+		for _, rule := range rules {
+			lines = append(lines, rule.String())
+		}
+		return lines
+	}
+
+	var e = newEditor("Object ACL - "+name, aclCols, func() []table.Row {
+		var rows []table.Row
+		for _, rule := range rules {
+			rows = append(rows, table.Row{rule.Entity, rule.Role})
+		}
+		return rows
+	})
+
+	e.add = func() form.Model {
+		return aclRuleForm(gcs.ACLRule{}, func(rule gcs.ACLRule) {
+			for i := range rules {
+				if rules[i].Entity == rule.Entity {
+					rules[i] = rule
+					return
+				}
+			}
+			rules = append(rules, rule)
+		})
+	}
+	e.edit = func(index int) form.Model {
+		return aclRuleForm(rules[index], func(rule gcs.ACLRule) {
+			rules[index] = rule
+		})
+	}
+	e.remove = func(index int) {
+		rules = append(rules[:index], rules[index+1:]...)
+	}
+	e.diff = func() []string {
+		return diffLines(aclStrings(old), aclStrings(rules))
+	}
+	e.apply = func() tea.Cmd {
+		var update = append([]gcs.ACLRule{}, rules...)
+		return func() tea.Msg {
+			if err := gcs.UpdateObjectACL(bucketName, name, old, update); err != nil {
+				return ResultMsg{Err: err}
+			}
+			return ResultMsg{Text: fmt.Sprintf("Updated ACL of %s: %d entries", name, len(update))}
+		}
+	}
+
+	return e
+}
@@ -0,0 +1,160 @@
+package dialog
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charan-kumar-137/gsui/form"
+	"github.com/charan-kumar-137/gsui/gcs"
+	"github.com/charan-kumar-137/gsui/list"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var (
+	lifecycleCols = []table.Column{
+		{Title: "Action", Width: 22},
+		{Title: "Conditions", Width: 40},
+	}
+)
+
+func validateNumber(value string) error {
+	if len(strings.TrimSpace(value)) == 0 {
+		return nil
+	}
+	if n, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64); err != nil || n < 0 {
+		return fmt.Errorf("%q is not a positive number", value)
+	}
+	return nil
+}
+
+func parseNumber(value string) int64 {
+	n, _ := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	return n
+}
+
+// Split a comma separated Field, dropping empty items
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); len(item) != 0 {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func lifecycleRuleForm(rule gcs.LifecycleRule, save func(gcs.LifecycleRule)) form.Model {
+	var numberString = func(n int64) string {
+		if n == 0 {
+			return ""
+		}
+		return fmt.Sprint(n)
+	}
+
+	var action = rule.Action
+	if len(action) == 0 {
+		action = gcs.LifecycleActions[0]
+	}
+
+	var storageClass = rule.StorageClass
+	if len(storageClass) == 0 {
+		storageClass = gcs.StorageClasses[1]
+	}
+
+	var objectState = rule.ObjectState
+	if len(objectState) == 0 {
+		objectState = gcs.ObjectStates[0]
+	}
+
+	var fields = []form.Field{
+		form.NewOptionField("Action", gcs.LifecycleActions, action),
+		form.NewOptionField("Target Storage Class (SetStorageClass)", gcs.StorageClasses, storageClass),
+		form.NewField("Age (days)", numberString(rule.AgeInDays)).WithValidate(validateNumber),
+		form.NewField("Created Before", rule.CreatedBefore).WithPlaceholder("YYYY-MM-DD"),
+		form.NewField("Matches Prefix", strings.Join(rule.MatchesPrefix, ",")).WithPlaceholder("logs/,tmp/"),
+		form.NewField("Matches Suffix", strings.Join(rule.MatchesSuffix, ",")).WithPlaceholder(".log,.tmp"),
+		form.NewField("Num Newer Versions", numberString(rule.NumNewerVersions)).WithValidate(validateNumber),
+		form.NewField("Matches Storage Class", strings.Join(rule.MatchesStorageClasses, ",")).WithPlaceholder("STANDARD,NEARLINE"),
+		form.NewOptionField("Object State", gcs.ObjectStates, objectState),
+	}
+
+	return form.New("Lifecycle Rule", "Leave a condition empty to ignore it.", fields, func(values []string) tea.Cmd {
+		var updated = rule.Edit()
+		updated.Action = values[0]
+		updated.StorageClass = values[1]
+		updated.AgeInDays = parseNumber(values[2])
+		updated.CreatedBefore = strings.TrimSpace(values[3])
+		updated.MatchesPrefix = splitList(values[4])
+		updated.MatchesSuffix = splitList(values[5])
+		updated.NumNewerVersions = parseNumber(values[6])
+		updated.MatchesStorageClasses = splitList(values[7])
+		updated.ObjectState = values[8]
+
+		if err := updated.Validate(); err != nil {
+			return result(ResultMsg{Err: err})
+		}
+		save(updated)
+		return edited()
+	})
+}
+
+// Open the Lifecycle Rules Editor for the selected Bucket
+func (m *Model) EditLifecycle(selected list.CurrentData) bool {
+	var bucket = selected.GetSelectedBucket()
+	if bucket == nil {
+		return false
+	}
+
+	var name = bucket.GetName()
+	var metageneration = bucket.GetMetageneration()
+	var old = bucket.GetLifecycleRules()
+	var rules = append([]gcs.LifecycleRule{}, old...)
+
+	var ruleStrings = func(rules []gcs.LifecycleRule) []string {
+		var lines []string
+		for _, rule := range rules {
+			lines = append(lines, rule.String())
+		}
+		return lines
+	}
+
+	var e = newEditor("Lifecycle Rules - "+name, lifecycleCols, func() []table.Row {
+		var rows []table.Row
+		for _, rule := range rules {
+			rows = append(rows, table.Row{rule.ActionString(), rule.ConditionString()})
+		}
+		return rows
+	})
+
+	e.add = func() form.Model {
+		return lifecycleRuleForm(gcs.LifecycleRule{}, func(rule gcs.LifecycleRule) {
+			rules = append(rules, rule)
+		})
+	}
+	e.edit = func(index int) form.Model {
+		return lifecycleRuleForm(rules[index], func(rule gcs.LifecycleRule) {
+			rules[index] = rule
+		})
+	}
+	e.remove = func(index int) {
+		rules = append(rules[:index], rules[index+1:]...)
+	}
+	e.diff = func() []string {
+		return diffLines(ruleStrings(old), ruleStrings(rules))
+	}
+	e.apply = func() tea.Cmd {
+		var update = append([]gcs.LifecycleRule{}, rules...)
+		return func() tea.Msg {
+			if err := gcs.UpdateLifecycleRules(name, metageneration, update); err != nil {
+				return ResultMsg{Err: err}
+			}
+			return ResultMsg{Text: fmt.Sprintf("Updated lifecycle of %s: %d rules", name, len(update)), Refresh: true}
+		}
+	}
+
+	m.setEditor(e)
+
+	return true
+}
@@ -0,0 +1,144 @@
+package dialog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charan-kumar-137/gsui/form"
+	"github.com/charan-kumar-137/gsui/gcs"
+	"github.com/charan-kumar-137/gsui/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var (
+	// URIs listed in the note of a batch Form before the rest are counted
+	maxListedObjects = 5
+
+	deleteConfirmation = "delete"
+)
+
+// Title suffix and note naming the Objects a batch Action acts on
+func describeObjects(objects []*gcs.Object) (string, string) {
+	var size = gcs.GetFormat().Size(gcs.TotalSize(objects))
+	if len(objects) == 1 {
+		return objects[0].GetName(), fmt.Sprintf("%s (%s)", objects[0].GetURI(), size)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d marked objects, total size %s:\n", len(objects), size)
+	for i, object := range objects {
+		if i == maxListedObjects {
+			fmt.Fprintf(&sb, "and %d more", len(objects)-maxListedObjects)
+			break
+		}
+		sb.WriteString(object.GetURI() + "\n")
+	}
+
+	return fmt.Sprintf("%d objects", len(objects)), strings.TrimSuffix(sb.String(), "\n")
+}
+
+func pluralObjects(count int) string {
+	if count == 1 {
+		return "1 object"
+	}
+	return fmt.Sprintf("%d objects", count)
+}
+
+// Open the Download Form for the marked Objects, else the selected Object
+func (m *Model) DownloadObjects(selected list.CurrentData) bool {
+	var objects = selected.GetTargetObjects()
+	if len(objects) == 0 {
+		return false
+	}
+
+	var title, note = describeObjects(objects)
+
+	var fields = []form.Field{
+		form.NewField("Directory", ".").WithValidate(func(value string) error {
+			if len(strings.TrimSpace(value)) == 0 {
+				return fmt.Errorf("directory is required")
+			}
+			return nil
+		}),
+	}
+
+	note += "\n\nObjects are saved under the directory by name, creating its folders."
+
+	m.setForm(form.New("Download - "+title, note, fields, func(values []string) tea.Cmd {
+		var dir = strings.TrimSpace(values[0])
+		return func() tea.Msg {
+			downloaded, err := gcs.DownloadObjects(objects, dir)
+			if err != nil {
+				return ResultMsg{Err: fmt.Errorf("downloaded %d of %d objects, then failed: %w", downloaded, len(objects), err)}
+			}
+			return ResultMsg{Text: fmt.Sprintf("Downloaded %s to %s", pluralObjects(downloaded), dir)}
+		}
+	}))
+
+	return true
+}
+
+// Open the Delete Form for the marked Objects, else the selected Object
+func (m *Model) DeleteObjects(selected list.CurrentData) bool {
+	var objects = selected.GetTargetObjects()
+	if len(objects) == 0 {
+		return false
+	}
+
+	var title, note = describeObjects(objects)
+
+	var fields = []form.Field{
+		form.NewField("Type "+deleteConfirmation+" to confirm", "").
+			WithPlaceholder(deleteConfirmation).
+			WithValidate(func(value string) error {
+				if value != deleteConfirmation {
+					return fmt.Errorf("type %q to delete", deleteConfirmation)
+				}
+				return nil
+			}),
+	}
+
+	note += "\n\nOnly the live version is deleted, versioned buckets keep it as noncurrent."
+
+	m.setForm(form.New("Delete - "+title, note, fields, func(_ []string) tea.Cmd {
+		return func() tea.Msg {
+			deleted, err := gcs.DeleteObjects(objects)
+			if err != nil {
+				return ResultMsg{Err: fmt.Errorf("deleted %d of %d objects, then failed: %w", deleted, len(objects), err)}
+			}
+			return ResultMsg{Text: "Deleted " + pluralObjects(deleted), Refresh: true}
+		}
+	}))
+
+	return true
+}
+
+// Open the Storage Class Form for the marked Objects, else the selected Object
+func (m *Model) ChangeStorageClass(selected list.CurrentData) bool {
+	var objects = selected.GetTargetObjects()
+	if len(objects) == 0 {
+		return false
+	}
+
+	var title, note = describeObjects(objects)
+	var current = objects[0].GetStorageClass()
+
+	var fields = []form.Field{
+		form.NewOptionField("Storage Class", withCurrent(gcs.StorageClasses, current), current),
+	}
+
+	note += "\n\nEach object is rewritten in place, which may incur early deletion charges on colder classes."
+
+	m.setForm(form.New("Storage Class - "+title, note, fields, func(values []string) tea.Cmd {
+		var class = values[0]
+		return func() tea.Msg {
+			changed, err := gcs.SetStorageClass(objects, class)
+			if err != nil {
+				return ResultMsg{Err: fmt.Errorf("changed %d of %d objects, then failed: %w", changed, len(objects), err)}
+			}
+			return ResultMsg{Text: fmt.Sprintf("Set storage class of %s to %s", pluralObjects(changed), class), Refresh: true}
+		}
+	}))
+
+	return true
+}
@@ -0,0 +1,136 @@
+package dialog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charan-kumar-137/gsui/form"
+	"github.com/charan-kumar-137/gsui/gcs"
+	"github.com/charan-kumar-137/gsui/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var (
+	lockOptions = []string{"Keep Unlocked", "Lock"}
+
+	// Phrase typed in the last step before locking
+	lockPhrase = "LOCK"
+
+	lockWarning = "Locking is IRREVERSIBLE. Once locked the retention period can never be reduced or removed, " +
+		"and the bucket cannot be deleted until every object has met the retention period."
+)
+
+// Open the Retention Policy Form for the selected Bucket
+func (m *Model) EditRetention(selected list.CurrentData) bool {
+	var bucket = selected.GetSelectedBucket()
+	if bucket == nil {
+		return false
+	}
+
+	var name = bucket.GetName()
+	var metageneration = bucket.GetMetageneration()
+	var current = bucket.GetRetentionPolicy()
+
+	var days = ""
+	if current != nil {
+		days = fmt.Sprint(current.Days)
+	}
+
+	var validateDays = func(value string) error {
+		if err := validateNumber(value); err != nil {
+			return err
+		}
+		return gcs.ValidateRetentionDays(parseNumber(value), current)
+	}
+
+	var fields = []form.Field{
+		form.NewField("Retention Period (days)", days).WithPlaceholder("0 removes the policy").WithValidate(validateDays),
+	}
+	if current == nil || !current.Locked {
+		fields = append(fields, form.NewOptionField("Lock Policy", lockOptions, lockOptions[0]))
+	}
+
+	var note = "Current policy: " + current.String()
+
+	m.setForm(form.New("Retention Policy - "+name, note, fields, func(values []string) tea.Cmd {
+		var days = parseNumber(values[0])
+		var lock = len(values) > 1 && values[1] == lockOptions[1]
+
+		if !lock {
+			return updateRetention(name, metageneration, current, days, false)
+		}
+
+		if days == 0 {
+			return result(ResultMsg{Err: fmt.Errorf("cannot lock an empty retention policy")})
+		}
+
+		return openForm(confirmLockForm(name, days, func() tea.Cmd {
+			return updateRetention(name, metageneration, current, days, true)
+		}))
+	}))
+
+	return true
+}
+
+// First the bucket name, then the lock phrase must be typed before locking
+func confirmLockForm(name string, days int64, lock func() tea.Cmd) form.Model {
+	var note = fmt.Sprintf("%s\n\nLock a retention period of %d days on gs://%s?", lockWarning, days, name)
+
+	var fields = []form.Field{
+		form.NewField("Type the bucket name to continue", "").WithPlaceholder(name).
+			WithValidate(func(value string) error {
+				if value != name {
+					return fmt.Errorf("bucket name does not match %q", name)
+				}
+				return nil
+			}),
+	}
+
+	return form.New("Lock Retention Policy (1/2)", note, fields, func(_ []string) tea.Cmd {
+		var fields = []form.Field{
+			form.NewField(fmt.Sprintf("Type %s to permanently lock", lockPhrase), "").
+				WithValidate(func(value string) error {
+					if strings.TrimSpace(value) != lockPhrase {
+						return fmt.Errorf("type %s to confirm", lockPhrase)
+					}
+					return nil
+				}),
+		}
+		var note = fmt.Sprintf("Last step. gs://%s will keep every object for at least %d days, forever.", name, days)
+
+		return openForm(form.New("Lock Retention Policy (2/2)", note, fields, func(_ []string) tea.Cmd {
+			return lock()
+		}))
+	})
+}
+
+func updateRetention(name string, metageneration int64, current *gcs.RetentionPolicy, days int64, lock bool) tea.Cmd {
+	// No policy to remove
+	if current == nil && days == 0 && !lock {
+		return result(ResultMsg{Text: "No changes to " + name})
+	}
+
+	return func() tea.Msg {
+		var err error
+
+		if current == nil || current.Days != days {
+			metageneration, err = gcs.UpdateRetentionPeriod(name, metageneration, days)
+			if err != nil {
+				return ResultMsg{Err: err}
+			}
+		}
+
+		if lock {
+			if err = gcs.LockRetentionPolicy(name, metageneration); err != nil {
+				return ResultMsg{Err: err}
+			}
+			return ResultMsg{Text: fmt.Sprintf("Locked retention policy of %s at %d days", name, days), Refresh: true}
+		}
+
+		if days == 0 {
+			return ResultMsg{Text: "Removed retention policy of " + name, Refresh: true}
+		}
+
+		return ResultMsg{Text: fmt.Sprintf("Set retention policy of %s to %d days", name, days), Refresh: true}
+	}
+}
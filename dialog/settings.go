@@ -0,0 +1,123 @@
+package dialog
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charan-kumar-137/gsui/form"
+	"github.com/charan-kumar-137/gsui/gcs"
+	"github.com/charan-kumar-137/gsui/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var (
+	enabledOptions = []string{"Enabled", "Disabled"}
+
+	// Empty Label Rows offered for new Labels
+	newLabelRows = 2
+)
+
+func enabledOption(enabled bool) string {
+	if enabled {
+		return enabledOptions[0]
+	}
+	return enabledOptions[1]
+}
+
+func withCurrent(options []string, current string) []string {
+	for _, option := range options {
+		if option == current {
+			return options
+		}
+	}
+	return append([]string{current}, options...)
+}
+
+func parseLabel(row string) (string, string, error) {
+	key, value, found := strings.Cut(strings.TrimSpace(row), "=")
+	if !found {
+		return "", "", fmt.Errorf("label %q must be key=value", row)
+	}
+	key = strings.TrimSpace(key)
+	value = strings.TrimSpace(value)
+	return key, value, gcs.ValidateLabel(key, value)
+}
+
+func validateLabelRow(row string) error {
+	if len(strings.TrimSpace(row)) == 0 {
+		return nil
+	}
+	_, _, err := parseLabel(row)
+	return err
+}
+
+// Open the Settings Form for the selected Bucket
+func (m *Model) EditBucketSettings(selected list.CurrentData) bool {
+	var bucket = selected.GetSelectedBucket()
+	if bucket == nil {
+		return false
+	}
+
+	var name = bucket.GetName()
+	var metageneration = bucket.GetMetageneration()
+	var old = bucket.GetSettings()
+
+	var fields = []form.Field{
+		form.NewOptionField("Versioning", enabledOptions, enabledOption(old.Versioning)),
+		form.NewOptionField("Uniform Access", enabledOptions, enabledOption(old.UniformAccess)),
+		form.NewOptionField("Public Access Prevention", withCurrent(gcs.PublicAccessPreventions, old.PublicAccessPrevention), old.PublicAccessPrevention),
+		form.NewOptionField("Default Storage Class", withCurrent(gcs.StorageClasses, old.StorageClass), old.StorageClass),
+	}
+	var labelStart = len(fields)
+
+	var keys []string
+	for k := range old.Labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		fields = append(fields, form.NewField("Label", k+"="+old.Labels[k]).WithValidate(validateLabelRow))
+	}
+	for i := 0; i < newLabelRows; i++ {
+		fields = append(fields, form.NewField("New Label", "").WithPlaceholder("key=value").WithValidate(validateLabelRow))
+	}
+
+	var note = "Clear a label row to remove the label."
+
+	m.setForm(form.New("Bucket Settings - "+name, note, fields, func(values []string) tea.Cmd {
+		var new = gcs.BucketSettings{
+			Versioning:             values[0] == enabledOptions[0],
+			UniformAccess:          values[1] == enabledOptions[0],
+			PublicAccessPrevention: values[2],
+			StorageClass:           values[3],
+			Labels:                 make(map[string]string),
+		}
+
+		for _, row := range values[labelStart:] {
+			if len(strings.TrimSpace(row)) == 0 {
+				continue
+			}
+			key, value, _ := parseLabel(row)
+			if _, ok := new.Labels[key]; ok {
+				return result(ResultMsg{Err: fmt.Errorf("duplicate label %q", key)})
+			}
+			new.Labels[key] = value
+		}
+
+		var changes = gcs.DiffSettings(old, new)
+		if len(changes) == 0 {
+			return result(ResultMsg{Text: "No changes to " + name})
+		}
+
+		return func() tea.Msg {
+			if err := gcs.UpdateBucketSettings(name, metageneration, old, new); err != nil {
+				return ResultMsg{Err: err}
+			}
+			return ResultMsg{Text: fmt.Sprintf("Updated %s: %s", name, strings.Join(changes, "; ")), Refresh: true}
+		}
+	}))
+
+	return true
+}
@@ -0,0 +1,113 @@
+package dialog
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charan-kumar-137/gsui/clipboard"
+	"github.com/charan-kumar-137/gsui/form"
+	"github.com/charan-kumar-137/gsui/gcs"
+	"github.com/charan-kumar-137/gsui/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mdp/qrterminal/v3"
+)
+
+var (
+	qrOptions = []string{"No", "Yes"}
+
+	defaultExpiry = "1h"
+)
+
+// Parse durations such as 30m, 12h or 7d
+func parseExpiry(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if days, found := strings.CutSuffix(value, "d"); found {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid expiry %q", value)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid expiry %q, use e.g. 30m, 12h or 7d", value)
+	}
+	return duration, nil
+}
+
+func renderQR(text string) string {
+	var sb strings.Builder
+	qrterminal.GenerateHalfBlock(text, qrterminal.L, &sb)
+	return sb.String()
+}
+
+// Open the Signed URL Form for the selected Object
+func (m *Model) SignURL(selected list.CurrentData) bool {
+	var object = selected.GetSelectedObject()
+	if object == nil {
+		return false
+	}
+
+	var bucket = object.GetBucketName()
+	var name = object.GetName()
+
+	var fields = []form.Field{
+		form.NewOptionField("Method", gcs.SignedURLMethods, gcs.SignedURLMethods[0]),
+		form.NewField("Expiry", defaultExpiry).WithPlaceholder("30m, 12h, 7d").
+			WithValidate(func(value string) error {
+				expiry, err := parseExpiry(value)
+				if err != nil {
+					return err
+				}
+				return gcs.SignOptions{Expiry: expiry}.Validate()
+			}),
+		form.NewField("Content-Type (optional)", "").WithPlaceholder("application/octet-stream"),
+		form.NewField("Signer (optional)", "").WithPlaceholder("key.json or sa@project.iam.gserviceaccount.com"),
+		form.NewOptionField("QR Code", qrOptions, qrOptions[0]),
+	}
+
+	var note = "Leave the signer empty to use the default credentials."
+
+	m.setForm(form.New("Signed URL - "+name, note, fields, func(values []string) tea.Cmd {
+		var expiry, _ = parseExpiry(values[1])
+		var options = gcs.SignOptions{
+			Method:      values[0],
+			Expiry:      expiry,
+			ContentType: strings.TrimSpace(values[2]),
+			Signer:      strings.TrimSpace(values[3]),
+		}
+		var qr = values[4] == qrOptions[1]
+
+		return func() tea.Msg {
+			url, err := gcs.SignedURL(bucket, name, options)
+			if err != nil {
+				return ResultMsg{Err: err}
+			}
+
+			var note = fmt.Sprintf("%s, expires %s\n\n%s", options.Method,
+				gcs.GetFormat().Time(time.Now().Add(options.Expiry)), url)
+			if qr {
+				note += "\n\n" + renderQR(url)
+			}
+			note += "\n\nPress enter to copy the URL to the clipboard."
+
+			return openFormMsg{form: form.New("Signed URL - "+name, note, nil, func(_ []string) tea.Cmd {
+				return copyToClipboard("signed URL", url)
+			})}
+		}
+	}))
+
+	return true
+}
+
+func copyToClipboard(what, text string) tea.Cmd {
+	return func() tea.Msg {
+		if err := clipboard.Copy(text); err != nil {
+			return ResultMsg{Err: err}
+		}
+		return ResultMsg{Text: "Copied " + what + " to clipboard"}
+	}
+}
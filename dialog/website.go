@@ -0,0 +1,186 @@
+package dialog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charan-kumar-137/gsui/form"
+	"github.com/charan-kumar-137/gsui/gcs"
+	"github.com/charan-kumar-137/gsui/list"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var (
+	notificationCols = []table.Column{
+		{Title: "ID", Width: 5},
+		{Title: "Topic", Width: 35},
+		{Title: "Events", Width: 20},
+		{Title: "Prefix", Width: 12},
+	}
+)
+
+// Open the Static Website Form for the selected Bucket
+func (m *Model) EditWebsite(selected list.CurrentData) bool {
+	var bucket = selected.GetSelectedBucket()
+	if bucket == nil {
+		return false
+	}
+
+	var name = bucket.GetName()
+	var metageneration = bucket.GetMetageneration()
+	var website = bucket.GetWebsite()
+
+	var fields = []form.Field{
+		form.NewField("Main Page Suffix", website.MainPage).WithPlaceholder("index.html"),
+		form.NewField("Not Found Page", website.NotFoundPage).WithPlaceholder("404.html"),
+	}
+
+	m.setForm(form.New("Static Website - "+name, "Clear both pages to disable the website configuration.", fields, func(values []string) tea.Cmd {
+		var updated = gcs.Website{MainPage: strings.TrimSpace(values[0]), NotFoundPage: strings.TrimSpace(values[1])}
+		if updated == website {
+			return result(ResultMsg{Text: "No changes to " + name})
+		}
+		return func() tea.Msg {
+			if err := gcs.UpdateWebsite(name, metageneration, updated); err != nil {
+				return ResultMsg{Err: err}
+			}
+			return ResultMsg{Text: "Updated website configuration of " + name, Refresh: true}
+		}
+	}))
+
+	return true
+}
+
+// Open the Access Logging Form for the selected Bucket
+func (m *Model) EditLogging(selected list.CurrentData) bool {
+	var bucket = selected.GetSelectedBucket()
+	if bucket == nil {
+		return false
+	}
+
+	var name = bucket.GetName()
+	var metageneration = bucket.GetMetageneration()
+	var logging = bucket.GetLogging()
+
+	var fields = []form.Field{
+		form.NewField("Log Bucket", logging.LogBucket).WithPlaceholder("bucket receiving the logs"),
+		form.NewField("Log Object Prefix", logging.Prefix).WithPlaceholder(name),
+	}
+
+	m.setForm(form.New("Access Logging - "+name, "Clear the log bucket to disable logging.", fields, func(values []string) tea.Cmd {
+		var updated = gcs.Logging{LogBucket: strings.TrimPrefix(strings.TrimSpace(values[0]), "gs://"), Prefix: strings.TrimSpace(values[1])}
+		if updated == logging {
+			return result(ResultMsg{Text: "No changes to " + name})
+		}
+		if len(updated.LogBucket) == 0 && len(updated.Prefix) != 0 {
+			return result(ResultMsg{Err: fmt.Errorf("a log prefix needs a log bucket")})
+		}
+		return func() tea.Msg {
+			if err := gcs.UpdateLogging(name, metageneration, updated); err != nil {
+				return ResultMsg{Err: err}
+			}
+			return ResultMsg{Text: "Updated logging configuration of " + name, Refresh: true}
+		}
+	}))
+
+	return true
+}
+
+func notificationStrings(notifications []gcs.Notification) []string {
+	var lines []string
+	for _, notification := range notifications {
+		lines = append(lines, notification.String())
+	}
+	return lines
+}
+
+func notificationForm(save func(gcs.Notification)) form.Model {
+	var fields = []form.Field{
+		form.NewField("Topic", "").WithPlaceholder("projects/<project>/topics/<topic>"),
+		form.NewField("Event Types", "").WithPlaceholder(strings.Join(gcs.NotificationEvents, ",")),
+		form.NewField("Object Name Prefix", ""),
+		form.NewOptionField("Payload", gcs.PayloadFormats, gcs.PayloadFormats[0]),
+	}
+
+	return form.New("Pub/Sub Notification", "Leave event types empty for all events.", fields, func(values []string) tea.Cmd {
+		var notification = gcs.Notification{
+			Topic:      strings.TrimSpace(values[0]),
+			EventTypes: splitList(strings.ToUpper(values[1])),
+			Prefix:     strings.TrimSpace(values[2]),
+			Payload:    values[3],
+		}
+
+		if err := notification.Validate(); err != nil {
+			return result(ResultMsg{Err: err})
+		}
+		save(notification)
+		return edited()
+	})
+}
+
+// Open the Pub/Sub Notifications Editor for the selected Bucket
+func (m *Model) EditNotifications(selected list.CurrentData) bool {
+	var bucket = selected.GetSelectedBucket()
+	if bucket == nil {
+		return false
+	}
+
+	var name = bucket.GetName()
+
+	m.load("Notifications - "+name, "Loading notifications...", func() (func(*Model), error) {
+		old, err := gcs.GetNotifications(name)
+		if err != nil {
+			return nil, err
+		}
+		return func(m *Model) {
+			m.setEditor(notificationEditor(name, old))
+		}, nil
+	})
+
+	return true
+}
+
+func notificationEditor(name string, old []gcs.Notification) *editor {
+	var notifications = append([]gcs.Notification{}, old...)
+
+	var e = newEditor("Notifications - "+name, notificationCols, func() []table.Row {
+		var rows []table.Row
+		for _, notification := range notifications {
+			var id = notification.ID
+			if len(id) == 0 {
+				id = "new"
+			}
+			var events = strings.Join(notification.EventTypes, ",")
+			if len(events) == 0 {
+				events = "all"
+			}
+			rows = append(rows, table.Row{id, notification.Topic, events, notification.Prefix})
+		}
+		return rows
+	})
+
+	// Notifications cannot be edited, only added and deleted
+	e.add = func() form.Model {
+		return notificationForm(func(notification gcs.Notification) {
+			notifications = append(notifications, notification)
+		})
+	}
+	e.remove = func(index int) {
+		notifications = append(notifications[:index], notifications[index+1:]...)
+	}
+	e.diff = func() []string {
+		return diffLines(notificationStrings(old), notificationStrings(notifications))
+	}
+	e.apply = func() tea.Cmd {
+		var update = append([]gcs.Notification{}, notifications...)
+		return func() tea.Msg {
+			if err := gcs.UpdateNotifications(name, old, update); err != nil {
+				return ResultMsg{Err: err}
+			}
+			return ResultMsg{Text: fmt.Sprintf("Updated notifications of %s: %d configs", name, len(update))}
+		}
+	}
+
+	return e
+}
@@ -8,7 +8,10 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/charan-kumar-137/gsui/bookmarks"
+	"github.com/charan-kumar-137/gsui/config"
 	dialog "github.com/charan-kumar-137/gsui/dialog"
+	"github.com/charan-kumar-137/gsui/gcs"
 	"github.com/charan-kumar-137/gsui/keys"
 
 	"github.com/charan-kumar-137/gsui/list"
@@ -39,6 +42,9 @@ var (
 	unUsedWidth  int = 2
 	unUsedHeight int = 2
 
+	// Share of the usable width taken by the List View
+	listWidthRatio float64 = 0.7
+
 	// Default Border Style
 	borderStyle lipgloss.Style = lipgloss.NewStyle().
 			Border(lipgloss.NormalBorder()).
@@ -50,6 +56,23 @@ var (
 				BorderForeground(lipgloss.Color("#3367D6"))
 )
 
+// Names of the Displays saved in the Session
+var activeNames = map[ActiveDisplay]string{
+	SEARCH: "search",
+	LIST:   "list",
+	DIALOG: "list",
+	NONE:   "none",
+}
+
+// Options of a gsui Run
+type Options struct {
+	// Path listed first, in place of the restored Session.
+	// An Object Path lists its parent with the Object selected.
+	Path string
+	// Restore the last Session and save it on quit
+	Session bool
+}
+
 // Display Model
 type Model struct {
 	// Search View
@@ -58,14 +81,32 @@ type Model struct {
 	listView list.Model
 	// Dialog View
 	dialogView dialog.Model
+	// Bookmark Picker shown over the Displays
+	bookmarks bookmarks.Model
+	picking   bool
 	// Active Display
 	active ActiveDisplay
+	// Listing of the start Path
+	startCmd tea.Cmd
 }
 
 // Toggle toggleFocus between displays
 func (m *Model) toggleFocus() {
 
-	m.active = (m.active + 1) % ActiveDisplay(TotalDisplay)
+	var next = (m.active + 1) % ActiveDisplay(TotalDisplay)
+
+	// Dialog only takes focus while a Form is open
+	if next == DIALOG && !m.dialogView.HasForm() {
+		next = NONE
+	}
+
+	m.setFocus(next)
+}
+
+// Focus the given display
+func (m *Model) setFocus(display ActiveDisplay) {
+
+	m.active = display
 
 	switch m.active {
 	case SEARCH:
@@ -76,7 +117,11 @@ func (m *Model) toggleFocus() {
 		m.listView.Focus()
 		m.searchView.Blur()
 		m.dialogView.Blur()
-	case DIALOG, NONE:
+	case DIALOG:
+		m.dialogView.Focus()
+		m.searchView.Blur()
+		m.listView.Blur()
+	case NONE:
 		m.blur()
 	}
 }
@@ -88,6 +133,11 @@ func (m *Model) blur() {
 	m.dialogView.Blur()
 }
 
+// Width of the List View content for the terminal width
+func getListWidth(actualWidth int) int {
+	return int(listWidthRatio * float64(actualWidth-unUsedWidth))
+}
+
 // Get Border
 func (m Model) getBorder(display ActiveDisplay) lipgloss.Style {
 	if m.active == display {
@@ -96,7 +146,61 @@ func (m Model) getBorder(display ActiveDisplay) lipgloss.Style {
 	return borderStyle
 }
 
+// Dialog Action opened on the selected row of List View
+type dialogAction struct {
+	binding key.Binding
+	open    func(*dialog.Model, list.CurrentData) bool
+}
+
+var dialogActions = []dialogAction{
+	{keys.Keys.Delete, (*dialog.Model).Delete},
+	{keys.Keys.EditBucket, (*dialog.Model).EditBucketSettings},
+	{keys.Keys.Lifecycle, (*dialog.Model).EditLifecycle},
+	{keys.Keys.IAM, (*dialog.Model).EditIAM},
+	{keys.Keys.CORS, (*dialog.Model).EditCORS},
+	{keys.Keys.Retention, (*dialog.Model).EditRetention},
+	{keys.Keys.Website, (*dialog.Model).EditWebsite},
+	{keys.Keys.Logging, (*dialog.Model).EditLogging},
+	{keys.Keys.Notifications, (*dialog.Model).EditNotifications},
+	{keys.Keys.Autoclass, (*dialog.Model).EditAutoclass},
+	{keys.Keys.SignURL, (*dialog.Model).SignURL},
+	{keys.Keys.Download, (*dialog.Model).DownloadObjects},
+	{keys.Keys.StorageClass, (*dialog.Model).ChangeStorageClass},
+	{keys.Keys.Bookmark, (*dialog.Model).AddBookmark},
+	{keys.Keys.Settings, (*dialog.Model).EditDisplaySettings},
+}
+
+// Open the Dialog Action bound to the key, focusing the Dialog
+func (m *Model) openDialogAction(msg tea.KeyMsg) bool {
+	for _, action := range dialogActions {
+		if key.Matches(msg, action.binding) && action.open(&m.dialogView, m.listView.GetSelectedRow()) {
+			m.setFocus(DIALOG)
+			return true
+		}
+	}
+	return false
+}
+
 func (m Model) Init() tea.Cmd {
+	return m.startCmd
+}
+
+// Show the Path in the Search View and search it
+func (m *Model) jumpTo(path string) tea.Cmd {
+	m.searchView.UpdateCurrentPath(path)
+	return m.searchView.Submit()
+}
+
+// List the Path, selecting the Object when it names one, or search it
+func (m *Model) openPath(path string) tea.Cmd {
+	if search.IsSearch(path) {
+		return m.jumpTo(path)
+	}
+
+	m.listView.Open(path)
+	m.searchView.UpdateCurrentPath(m.listView.GetCurrentPath())
+	m.searchView.SetError(m.listView.GetError())
+
 	return nil
 }
 
@@ -105,8 +209,22 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 
 	case tea.KeyMsg:
+		// Keys belong to the Bookmark Picker while it is shown
+		if m.picking {
+			var cmd tea.Cmd
+			m.bookmarks, cmd = m.bookmarks.Update(msg)
+			return m, cmd
+		}
+		// Keys belong to the open Form, the List Filter or the Mark Pattern
+		if m.active == DIALOG || m.listView.IsFiltering() || m.listView.IsMarking() {
+			break
+		}
 		switch {
+		case key.Matches(msg, keys.Keys.Bookmarks) && m.active != SEARCH:
+			m.picking = true
+			return m, m.bookmarks.Open()
 		case key.Matches(msg, keys.Keys.Escape):
+			m.listView.CancelSearch()
 			m.blur()
 		case key.Matches(msg, keys.Keys.Tab):
 			m.toggleFocus()
@@ -114,10 +232,42 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.active == NONE {
 				return m, tea.Quit
 			}
+		default:
+			if m.active != LIST {
+				break
+			}
+			if cmd := m.dialogView.CopyURL(msg, m.listView.GetSelectedRow()); cmd != nil {
+				return m, cmd
+			}
+			if m.openDialogAction(msg) {
+				return m, m.dialogView.StartedCmd()
+			}
+		}
+	case search.GlobMsg:
+		cmds = append(cmds, m.listView.StartGlobSearch(msg.Bucket, msg.Glob, msg.Filter))
+	case search.GlobalSearchMsg:
+		cmds = append(cmds, m.listView.StartGlobalSearch(msg.Pattern, msg.Filter))
+	case search.NavigateMsg:
+		if msg.Typed {
+			m.listView.Preview(msg.Path)
+		} else {
+			m.listView.UpdateCurrentPath(msg.Path)
+		}
+		m.searchView.SetError(m.listView.GetError())
+	case bookmarks.SelectMsg:
+		m.picking = false
+		m.setFocus(LIST)
+		cmds = append(cmds, m.openPath(msg.Path))
+	case bookmarks.CloseMsg:
+		m.picking = false
+	case dialog.ClosedMsg:
+		if msg.Refresh {
+			m.listView.Refresh()
 		}
+		m.setFocus(LIST)
 	case tea.WindowSizeMsg:
 		m.searchView.SetDimension(msg.Width, msg.Height)
-		m.listView.SetDimension(msg.Width, msg.Height)
+		m.listView.SetDimension(getListWidth(msg.Width), msg.Height)
 		m.dialogView.SetDimension(msg.Width, msg.Height)
 	}
 
@@ -131,16 +281,19 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	m.listView = listViewUpdateAction
 	cmds = append(cmds, listViewUpdateActionCmd)
 
-	// Update based on active display
-	switch m.active {
-	case SEARCH:
-		m.listView.UpdateCurrentPath(m.searchView.GetCurrentPath())
-	case LIST:
+	// Search View navigates the List View through NavigateMsg
+	if m.active == LIST {
 		m.searchView.UpdateCurrentPath(m.listView.GetCurrentPath())
+		m.searchView.SetError(m.listView.GetError())
 	}
 
-	// Update Dialog View
-	dialogViewUpdate, dialogViewUpdateCmd := m.dialogView.Update(m.listView.GetSelectedRow())
+	// Update Dialog View Form
+	dialogViewUpdate, dialogViewUpdateCmd := m.dialogView.Update(msg)
+	m.dialogView = dialogViewUpdate
+	cmds = append(cmds, dialogViewUpdateCmd)
+
+	// Update Dialog View Details
+	dialogViewUpdate, dialogViewUpdateCmd = m.dialogView.Update(m.listView.GetSelectedRow())
 	m.dialogView = dialogViewUpdate
 	cmds = append(cmds, dialogViewUpdateCmd)
 
@@ -154,12 +307,19 @@ func (m Model) View() string {
 	var usableWidth = actualWidth - unUsedWidth
 	var usableHeight = actualHeight - unUsedHeight
 
+	// Bookmark Picker replaces the Displays while shown
+	if m.picking {
+		var picker = m.bookmarks
+		picker.SetDimension(usableWidth, usableHeight)
+		return picker.View()
+	}
+
 	// Search View
 	var searchView = m.getBorder(SEARCH).Width(usableWidth).Render(m.searchView.View())
 	var searchViewHeight = lipgloss.Height(searchView)
 
 	// List View
-	var listWidth = int(0.7 * float64(usableWidth))
+	var listWidth = getListWidth(actualWidth)
 	var listHeight = usableHeight - (searchViewHeight)
 	var listView = m.getBorder(LIST).Width(listWidth).Height(listHeight).Render(m.listView.View())
 
@@ -177,7 +337,52 @@ func (m Model) View() string {
 	return view
 }
 
-func Run() {
+// Where the user is, saved on quit
+func (m Model) session() config.Session {
+	var path, cursor, offset = m.listView.GetListing()
+	var order = m.listView.GetSort()
+
+	return config.Session{
+		Path:           path,
+		Cursor:         cursor,
+		Offset:         offset,
+		Active:         activeNames[m.active],
+		SortColumn:     order.Column,
+		SortDescending: order.Descending,
+	}
+}
+
+// Return to where the last Session was left
+func (m *Model) restoreSession(session config.Session) {
+	m.listView.SetSort(gcs.SortOrder{Column: session.SortColumn, Descending: session.SortDescending})
+
+	// Searches are not run again unasked, as a Global Search scans the whole Project
+	if search.IsSearch(session.Path) {
+		session.Path, session.Cursor, session.Offset = "", 0, 0
+	}
+
+	m.listView.Restore(session.Path, session.Cursor, session.Offset)
+	m.searchView.UpdateCurrentPath(m.listView.GetCurrentPath())
+
+	for display, name := range activeNames {
+		if name == session.Active && display != DIALOG {
+			m.setFocus(display)
+		}
+	}
+}
+
+// Run gsui with the given Options
+func Run(options Options) {
+
+	// Display Settings apply from the first Listing
+	settings, err := config.LoadSettings()
+	if err != nil {
+		log.Println(err)
+	}
+	gcs.SetFormat(settings.Format)
+	if err := gcs.SetColumns(settings.BucketColumns, settings.ObjectColumns); err != nil {
+		log.Println(err)
+	}
 
 	var searchView = search.New()
 	var listView = list.New()
@@ -187,12 +392,32 @@ func Run() {
 		searchView: searchView,
 		listView:   listView,
 		dialogView: dialogView,
+		bookmarks:  bookmarks.New(),
 		active:     NONE,
 	}
 
+	if len(options.Path) != 0 {
+		m.setFocus(LIST)
+		m.startCmd = m.openPath(options.Path)
+	} else if options.Session {
+		session, found, err := config.LoadSession()
+		if err != nil {
+			log.Println(err)
+		} else if found {
+			m.restoreSession(session)
+		}
+	}
+
 	p := tea.NewProgram(m, tea.WithAltScreen())
-	if _, err := p.Run(); err != nil {
+	final, err := p.Run()
+	if err != nil {
 		log.Fatal("Failed to start gsui", err)
 		os.Exit(1)
 	}
+
+	if options.Session {
+		if err := config.SaveSession(final.(Model).session()); err != nil {
+			log.Println(err)
+		}
+	}
 }
@@ -0,0 +1,314 @@
+package form
+
+import (
+	"strings"
+
+	"github.com/charan-kumar-137/gsui/keys"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	titleStyle  = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#3367D6"))
+	labelStyle  = lipgloss.NewStyle().Bold(true)
+	activeStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#3367D6"))
+	errorStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("#D93025"))
+	hintStyle   = lipgloss.NewStyle().Faint(true)
+)
+
+// Sent when the user cancels the Form
+type CancelMsg struct{}
+
+// Called with the Field values (in Field order) once all Fields are valid
+type SubmitFunc func(values []string) tea.Cmd
+
+// Single labelled input of a Form
+type Field struct {
+	label string
+	input textinput.Model
+	area  textarea.Model
+	// Multi line Fields keep enter and arrows for editing
+	multiline bool
+	options   []string
+	option    int
+	validate  func(string) error
+}
+
+// Free text Field
+func NewField(label, value string) Field {
+	ti := textinput.New()
+	ti.Prompt = "> "
+	ti.SetValue(value)
+
+	return Field{label: label, input: ti}
+}
+
+// Multi line text Field, such as raw JSON
+func NewTextAreaField(label, value string, height int) Field {
+	ta := textarea.New()
+	ta.ShowLineNumbers = false
+	ta.SetHeight(height)
+	ta.SetValue(value)
+
+	return Field{label: label, area: ta, multiline: true}
+}
+
+// Field cycling between fixed options with left / right
+func NewOptionField(label string, options []string, value string) Field {
+	var field = Field{label: label, options: options}
+
+	for i, option := range options {
+		if option == value {
+			field.option = i
+		}
+	}
+
+	return field
+}
+
+func (f Field) WithValidate(validate func(string) error) Field {
+	f.validate = validate
+	return f
+}
+
+func (f Field) WithPlaceholder(placeholder string) Field {
+	f.input.Placeholder = placeholder
+	return f
+}
+
+func (f Field) Value() string {
+	if f.options != nil {
+		return f.options[f.option]
+	}
+	if f.multiline {
+		return f.area.Value()
+	}
+	return f.input.Value()
+}
+
+func (f Field) view(active bool) string {
+	var label = labelStyle.Render(f.label)
+	if active {
+		label = activeStyle.Render("▸ " + f.label)
+	}
+
+	if f.multiline {
+		return label + "\n" + f.area.View()
+	}
+
+	if f.options == nil {
+		return label + "\n" + f.input.View()
+	}
+
+	var sb strings.Builder
+	for i, option := range f.options {
+		if i == f.option {
+			sb.WriteString(activeStyle.Render("[" + option + "]"))
+		} else {
+			sb.WriteString(" " + option + " ")
+		}
+		sb.WriteString(" ")
+	}
+
+	return label + "\n  " + sb.String()
+}
+
+// Form Model
+type Model struct {
+	title  string
+	note   string
+	fields []Field
+	cursor int
+	err    error
+	busy   bool
+	// Shown while the content of the Form is fetched
+	loading bool
+	submit  SubmitFunc
+	width   int
+	height  int
+}
+
+func New(title, note string, fields []Field, submit SubmitFunc) Model {
+	var m = Model{title: title, note: note, fields: fields, submit: submit}
+	m.focusField(0)
+	return m
+}
+
+// Form shown while its content is fetched, only esc cancels it
+func NewLoading(title, note string) Model {
+	return Model{title: title, note: note, loading: true}
+}
+
+func (m Model) IsLoading() bool {
+	return m.loading
+}
+
+func (m *Model) focusField(index int) {
+	if len(m.fields) == 0 {
+		return
+	}
+
+	m.cursor = (index + len(m.fields)) % len(m.fields)
+	for i := range m.fields {
+		if i == m.cursor {
+			m.fields[i].input.Focus()
+			m.fields[i].area.Focus()
+		} else {
+			m.fields[i].input.Blur()
+			m.fields[i].area.Blur()
+		}
+	}
+}
+
+// Show an error and let the user correct the input
+func (m *Model) SetError(err error) {
+	m.err = err
+	m.busy = false
+}
+
+func (m Model) IsBusy() bool {
+	return m.busy
+}
+
+func (m *Model) SetDimension(width, height int) {
+	m.width = width
+	m.height = height
+	for i := range m.fields {
+		if m.fields[i].multiline {
+			m.fields[i].area.SetWidth(width)
+		}
+	}
+}
+
+func (m Model) values() []string {
+	var values []string
+	for _, field := range m.fields {
+		values = append(values, field.Value())
+	}
+	return values
+}
+
+func (m *Model) validate() error {
+	for i, field := range m.fields {
+		if field.validate == nil {
+			continue
+		}
+		if err := field.validate(field.Value()); err != nil {
+			m.focusField(i)
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Model) doSubmit() (Model, tea.Cmd) {
+	if m.err = m.validate(); m.err != nil {
+		return m, nil
+	}
+	m.busy = true
+	return m, m.submit(m.values())
+}
+
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+
+	if m.busy {
+		return m, nil
+	}
+
+	if m.loading {
+		if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, keys.Keys.Escape) {
+			return m, func() tea.Msg { return CancelMsg{} }
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		var multiline = len(m.fields) != 0 && m.fields[m.cursor].multiline
+
+		switch {
+		case key.Matches(msg, keys.Keys.Escape):
+			return m, func() tea.Msg { return CancelMsg{} }
+		case key.Matches(msg, keys.Keys.Save):
+			return m.doSubmit()
+		case multiline && key.Matches(msg, keys.Keys.Tab):
+			m.focusField(m.cursor + 1)
+			return m, nil
+		case multiline && msg.String() == "shift+tab":
+			m.focusField(m.cursor - 1)
+			return m, nil
+		case multiline:
+			var field = &m.fields[m.cursor]
+			field.area, cmd = field.area.Update(msg)
+			return m, cmd
+		case key.Matches(msg, keys.Keys.Next):
+			m.focusField(m.cursor + 1)
+			return m, nil
+		case key.Matches(msg, keys.Keys.Prev):
+			m.focusField(m.cursor - 1)
+			return m, nil
+		case key.Matches(msg, keys.Keys.Submit):
+			if m.cursor < len(m.fields)-1 {
+				m.focusField(m.cursor + 1)
+				return m, nil
+			}
+			return m.doSubmit()
+		}
+
+		if len(m.fields) == 0 {
+			return m, nil
+		}
+
+		var field = &m.fields[m.cursor]
+		if field.options != nil {
+			switch msg.String() {
+			case "left":
+				field.option = (field.option - 1 + len(field.options)) % len(field.options)
+			case "right", " ":
+				field.option = (field.option + 1) % len(field.options)
+			}
+			return m, nil
+		}
+
+		field.input, cmd = field.input.Update(msg)
+	}
+
+	return m, cmd
+}
+
+func (m Model) View() string {
+	var sb strings.Builder
+
+	sb.WriteString(titleStyle.Render(m.title) + "\n\n")
+
+	if len(m.note) != 0 {
+		sb.WriteString(m.note + "\n\n")
+	}
+
+	for i, field := range m.fields {
+		sb.WriteString(field.view(i == m.cursor) + "\n")
+	}
+
+	if m.err != nil {
+		sb.WriteString("\n" + errorStyle.Render(m.err.Error()) + "\n")
+	}
+
+	if m.loading {
+		sb.WriteString("\n" + hintStyle.Render("esc: cancel") + "\n")
+	} else if m.busy {
+		sb.WriteString("\n" + hintStyle.Render("Working...") + "\n")
+	} else {
+		sb.WriteString("\n" + hintStyle.Render("enter: confirm • ctrl+s: submit • tab: next • esc: cancel") + "\n")
+	}
+
+	return lipgloss.NewStyle().Render(sb.String())
+}
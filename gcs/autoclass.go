@@ -0,0 +1,38 @@
+package gcs
+
+import (
+	"cloud.google.com/go/storage"
+)
+
+var (
+	TerminalStorageClasses = []string{"NEARLINE", "ARCHIVE"}
+)
+
+// Autoclass Configuration of a Bucket
+type Autoclass struct {
+	Enabled              bool
+	TerminalStorageClass string
+}
+
+func (b Bucket) GetAutoclass() Autoclass {
+	if b.attrs.Autoclass == nil {
+		return Autoclass{TerminalStorageClass: TerminalStorageClasses[0]}
+	}
+	return Autoclass{Enabled: b.attrs.Autoclass.Enabled, TerminalStorageClass: b.attrs.Autoclass.TerminalStorageClass}
+}
+
+func (b Bucket) autoclassShort() string {
+	if b.attrs.Autoclass == nil || !b.attrs.Autoclass.Enabled {
+		return "Off"
+	}
+	return b.attrs.Autoclass.TerminalStorageClass
+}
+
+func UpdateAutoclass(bucket string, metageneration int64, autoclass Autoclass) error {
+	var update = &storage.Autoclass{Enabled: autoclass.Enabled}
+	if autoclass.Enabled {
+		update.TerminalStorageClass = autoclass.TerminalStorageClass
+	}
+
+	return updateBucket(bucket, metageneration, storage.BucketAttrsToUpdate{Autoclass: update})
+}
@@ -0,0 +1,112 @@
+package gcs
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"cloud.google.com/go/storage"
+)
+
+// Total Size of the Objects
+func TotalSize(objects []*Object) int64 {
+	var total int64
+	for _, object := range objects {
+		total += object.GetSize()
+	}
+	return total
+}
+
+// Delete the live Version of each Object, returning how many were deleted before any failure
+func DeleteObjects(objects []*Object) (int, error) {
+	var deleted = 0
+
+	for _, object := range objects {
+		// Already gone Objects are not counted as deleted
+		err := client.Bucket(object.GetBucketName()).Object(object.GetName()).Delete(ctx)
+		if err == storage.ErrObjectNotExist {
+			continue
+		}
+		if err != nil {
+			return deleted, fmt.Errorf("%s: %w", object.GetURI(), err)
+		}
+		deleted += 1
+	}
+
+	return deleted, nil
+}
+
+// Rewrite each Object in place with the Storage Class, returning how many were changed before any failure
+func SetStorageClass(objects []*Object, class string) (int, error) {
+	var changed = 0
+
+	for _, object := range objects {
+		if object.GetStorageClass() == class {
+			changed += 1
+			continue
+		}
+
+		var handle = client.Bucket(object.GetBucketName()).Object(object.GetName())
+		copier := handle.If(storage.Conditions{GenerationMatch: object.GetAttrs().Generation}).CopierFrom(handle)
+		copier.StorageClass = class
+		if _, err := copier.Run(ctx); err != nil {
+			return changed, fmt.Errorf("%s: %w", object.GetURI(), err)
+		}
+		changed += 1
+	}
+
+	return changed, nil
+}
+
+// Download each Object under dir, keeping the folders of its name.
+// Returns how many were downloaded before any failure.
+func DownloadObjects(objects []*Object, dir string) (int, error) {
+	var downloaded = 0
+
+	for _, object := range objects {
+		if err := downloadObject(object, dir); err != nil {
+			return downloaded, fmt.Errorf("%s: %w", object.GetURI(), err)
+		}
+		downloaded += 1
+	}
+
+	return downloaded, nil
+}
+
+func downloadObject(object *Object, dir string) error {
+	var path = filepath.Join(dir, filepath.FromSlash(object.GetName()))
+
+	// Names such as ../x must not escape dir, ..data is a plain name
+	rel, err := filepath.Rel(dir, path)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return DataError{"Object name is not a valid file path"}
+	}
+
+	// Folder placeholders such as logs/ are created as directories
+	if strings.HasSuffix(object.GetName(), "/") {
+		return os.MkdirAll(path, 0o755)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	reader, err := client.Bucket(object.GetBucketName()).Object(object.GetName()).NewReader(ctx)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(file, reader); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
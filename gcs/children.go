@@ -0,0 +1,99 @@
+package gcs
+
+import (
+	"sync"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+)
+
+var (
+	childrenCacheTTL = time.Minute
+
+	childrenCache     = map[string]cachedChildren{}
+	childrenCacheLock sync.Mutex
+
+	// Most names kept per listing, enough for completion
+	maxChildren = 1000
+)
+
+type cachedChildren struct {
+	names   []string
+	fetched time.Time
+}
+
+// Bucket Names when bucket is empty, else the Object Names and Prefixes directly under prefix.
+// Listings are cached for a minute.
+func ListChildren(bucket, prefix string) ([]string, error) {
+	var cacheKey = bucket + "/" + prefix
+
+	childrenCacheLock.Lock()
+	cached, ok := childrenCache[cacheKey]
+	childrenCacheLock.Unlock()
+
+	if ok && time.Since(cached.fetched) < childrenCacheTTL {
+		return cached.names, nil
+	}
+
+	var names []string
+	var err error
+
+	if len(bucket) == 0 {
+		names, err = listBucketNames()
+	} else {
+		names, err = listDelimited(bucket, prefix)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	childrenCacheLock.Lock()
+	childrenCache[cacheKey] = cachedChildren{names: names, fetched: time.Now()}
+	childrenCacheLock.Unlock()
+
+	return names, nil
+}
+
+func listBucketNames() ([]string, error) {
+	var names []string
+
+	it := client.Buckets(ctx, projectId)
+	for len(names) < maxChildren {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, attrs.Name)
+	}
+
+	return names, nil
+}
+
+func listDelimited(bucket, prefix string) ([]string, error) {
+	var names []string
+
+	query := &storage.Query{Prefix: prefix, Delimiter: "/"}
+	query.SetAttrSelection([]string{"Name"})
+
+	it := client.Bucket(bucket).Objects(ctx, query)
+	for len(names) < maxChildren {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(attrs.Prefix) != 0 {
+			names = append(names, attrs.Prefix)
+		} else {
+			names = append(names, attrs.Name)
+		}
+	}
+
+	return names, nil
+}
@@ -0,0 +1,238 @@
+package gcs
+
+import (
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+)
+
+var (
+	// Columns of custom Metadata are named by this prefix and the Metadata key
+	MetadataColumnPrefix = "meta:"
+
+	// Every Bucket Column, in the order offered
+	bucketColumns = []column[*Bucket]{
+		{"Name", 40, func(b *Bucket) string { return b.attrs.Name }},
+		{"Created", 20, func(b *Bucket) string { return format.Time(b.attrs.Created) }},
+		{"LocationType", 15, func(b *Bucket) string { return b.attrs.LocationType }},
+		{"Location", 10, func(b *Bucket) string { return b.attrs.Location }},
+		{"DefaultStorageClass", 20, func(b *Bucket) string { return b.attrs.StorageClass }},
+		{"Autoclass", 10, func(b *Bucket) string { return b.autoclassShort() }},
+		{"HNS", 8, func(b *Bucket) string { return b.namespace() }},
+		{"Placement", 25, func(b *Bucket) string { return b.placement() }},
+		{"Replication", 12, func(b *Bucket) string { return b.replication() }},
+		{"Versioning", 10, func(b *Bucket) string { return b.protection() }},
+		{"Access Control", 14, func(b *Bucket) string { return b.accessControl() }},
+		{"Metageneration", 14, func(b *Bucket) string { return fmt.Sprint(b.attrs.MetaGeneration) }},
+		{"Labels", 25, func(b *Bucket) string { return b.labels() }},
+	}
+
+	// Every Object Column, in the order offered, besides the Metadata Columns
+	objectColumns = []column[*Object]{
+		{"Name", 40, func(o *Object) string { return o.attrs.Name }},
+		{"Bucket", 25, func(o *Object) string { return o.attrs.Bucket }},
+		{"Size", 10, func(o *Object) string { return format.Size(o.attrs.Size) }},
+		{"Type", 10, func(o *Object) string { return o.attrs.ContentType }},
+		{"Storage Class", 15, func(o *Object) string { return o.attrs.StorageClass }},
+		{"Created", 20, func(o *Object) string { return format.Time(o.attrs.Created) }},
+		{"Last Modified", 20, func(o *Object) string { return format.Time(o.attrs.Updated) }},
+		{"Custom Time", 20, func(o *Object) string { return format.Time(o.attrs.CustomTime) }},
+		{"MD5", 24, func(o *Object) string { return base64.StdEncoding.EncodeToString(o.attrs.MD5) }},
+		{"CRC32C", 10, func(o *Object) string { return crc32cString(o.attrs.CRC32C) }},
+		{"Generation", 18, func(o *Object) string { return fmt.Sprint(o.attrs.Generation) }},
+		{"Metageneration", 14, func(o *Object) string { return fmt.Sprint(o.attrs.Metageneration) }},
+		{"Owner", 25, func(o *Object) string { return o.attrs.Owner }},
+	}
+
+	// Columns shown unless the Settings choose others
+	DefaultBucketColumns = []string{"Name", "Created", "LocationType", "Location", "DefaultStorageClass"}
+	DefaultObjectColumns = []string{"Name", "Size", "Type", "Storage Class", "Created", "Last Modified"}
+
+	// Bucket Columns added by the details toggle
+	bucketDetailColumns = []string{"Autoclass", "HNS", "Placement", "Replication"}
+
+	shownBucketColumns = DefaultBucketColumns
+	shownObjectColumns = DefaultObjectColumns
+
+	metadataColumnWidth = 15
+)
+
+// Column of a Listing with its preferred Width and how a row presents it
+type column[T any] struct {
+	title string
+	width int
+	value func(T) string
+}
+
+// CRC32C as base64 of its big-endian bytes, as shown by gcloud
+func crc32cString(crc uint32) string {
+	return base64.StdEncoding.EncodeToString([]byte{byte(crc >> 24), byte(crc >> 16), byte(crc >> 8), byte(crc)})
+}
+
+func findColumn[T any](columns []column[T], title string) (column[T], bool) {
+	for _, col := range columns {
+		if strings.EqualFold(col.title, title) {
+			return col, true
+		}
+	}
+	return column[T]{}, false
+}
+
+func findObjectColumn(title string) (column[*Object], bool) {
+	if key, ok := strings.CutPrefix(title, MetadataColumnPrefix); ok && len(key) != 0 {
+		return column[*Object]{title, metadataColumnWidth, func(o *Object) string { return o.attrs.Metadata[key] }}, true
+	}
+	return findColumn(objectColumns, title)
+}
+
+func titles[T any](columns []column[T]) []string {
+	var titles []string
+	for _, col := range columns {
+		titles = append(titles, col.title)
+	}
+	return titles
+}
+
+// Titles of every Bucket Column
+func BucketColumns() []string {
+	return titles(bucketColumns)
+}
+
+// Titles of every Object Column, custom Metadata Columns aside
+func ObjectColumns() []string {
+	return titles(objectColumns)
+}
+
+// Canonical titles of the chosen Columns, with Name first as filtering matches on it
+func resolveColumns(chosen []string, find func(string) (string, bool)) ([]string, error) {
+	var resolved = []string{"Name"}
+	var unknown []string
+
+	for _, title := range chosen {
+		title = strings.TrimSpace(title)
+		canonical, ok := find(title)
+		switch {
+		case len(title) == 0 || canonical == "Name":
+		case !ok:
+			unknown = append(unknown, title)
+		default:
+			resolved = append(resolved, canonical)
+		}
+	}
+
+	if len(unknown) != 0 {
+		return nil, DataError{"Unknown columns: " + strings.Join(unknown, ", ")}
+	}
+	return resolved, nil
+}
+
+func ResolveBucketColumns(chosen []string) ([]string, error) {
+	return resolveColumns(chosen, func(title string) (string, bool) {
+		col, ok := findColumn(bucketColumns, title)
+		return col.title, ok
+	})
+}
+
+func ResolveObjectColumns(chosen []string) ([]string, error) {
+	return resolveColumns(chosen, func(title string) (string, bool) {
+		col, ok := findObjectColumn(title)
+		return col.title, ok
+	})
+}
+
+// Show the chosen Columns in later Listings, the defaults when none are chosen
+func SetColumns(buckets, objects []string) error {
+	var err error
+	var bucketTitles, objectTitles = DefaultBucketColumns, DefaultObjectColumns
+
+	if len(buckets) != 0 {
+		if bucketTitles, err = ResolveBucketColumns(buckets); err != nil {
+			return err
+		}
+	}
+	if len(objects) != 0 {
+		if objectTitles, err = ResolveObjectColumns(objects); err != nil {
+			return err
+		}
+	}
+
+	shownBucketColumns, shownObjectColumns = bucketTitles, objectTitles
+	return nil
+}
+
+func GetColumns() ([]string, []string) {
+	return shownBucketColumns, shownObjectColumns
+}
+
+func tableData[T any](columns []column[T], items []T) ([]table.Column, []table.Row) {
+	var cols []table.Column
+	for _, col := range columns {
+		cols = append(cols, table.Column{Title: col.title, Width: col.width})
+	}
+
+	var rows []table.Row
+	for _, item := range items {
+		var row = make(table.Row, len(columns))
+		for i, col := range columns {
+			row[i] = col.value(item)
+		}
+		rows = append(rows, row)
+	}
+
+	return cols, rows
+}
+
+func (data Data) bucketColumns(details bool) []column[*Bucket] {
+	var shown = shownBucketColumns
+	if details {
+		shown = append(slices.Clone(shown), bucketDetailColumns...)
+	}
+
+	var columns []column[*Bucket]
+	for i, title := range shown {
+		if slices.Contains(shown[:i], title) {
+			continue
+		}
+		if col, ok := findColumn(bucketColumns, title); ok {
+			columns = append(columns, col)
+		}
+	}
+	return columns
+}
+
+func (data Data) objectColumns() []column[*Object] {
+	var shown = shownObjectColumns
+	// Objects listed across Buckets tell their Bucket after the Name
+	if data.global && !slices.Contains(shown, "Bucket") {
+		shown = slices.Insert(slices.Clone(shown), 1, "Bucket")
+	}
+
+	var columns []column[*Object]
+	for _, title := range shown {
+		if col, ok := findObjectColumn(title); ok {
+			columns = append(columns, col)
+		}
+	}
+	return columns
+}
+
+// Table Columns at their preferred Widths, details adds the optional Bucket Columns
+func (data Data) GetTableColumns(details bool) []table.Column {
+	if data.IsBucket {
+		cols, _ := tableData(data.bucketColumns(details), nil)
+		return cols
+	}
+	cols, _ := tableData(data.objectColumns(), nil)
+	return cols
+}
+
+// Table Columns and Rows, details adds the optional Bucket Columns
+func (data Data) GetTableData(details bool) ([]table.Column, []table.Row) {
+	if data.IsBucket {
+		return tableData(data.bucketColumns(details), data.buckets)
+	}
+	return tableData(data.objectColumns(), data.objects)
+}
@@ -0,0 +1,100 @@
+package gcs
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+)
+
+var (
+	corsMethods = []string{"GET", "HEAD", "PUT", "POST", "DELETE", "OPTIONS", "PATCH", "*"}
+)
+
+// CORS Entry of a Bucket, in the JSON format used by gsutil / gcloud
+type CORSEntry struct {
+	Origins         []string `json:"origin"`
+	Methods         []string `json:"method"`
+	ResponseHeaders []string `json:"responseHeader,omitempty"`
+	MaxAgeSeconds   int64    `json:"maxAgeSeconds,omitempty"`
+}
+
+func (c CORSEntry) Validate() error {
+	if len(c.Origins) == 0 {
+		return DataError{"CORS entry needs at least one origin"}
+	}
+	if len(c.Methods) == 0 {
+		return DataError{"CORS entry needs at least one method"}
+	}
+	for _, method := range c.Methods {
+		var valid = false
+		for _, known := range corsMethods {
+			if method == known {
+				valid = true
+			}
+		}
+		if !valid {
+			return DataError{fmt.Sprintf("unknown method %q, expected one of %s", method, strings.Join(corsMethods, ","))}
+		}
+	}
+	if c.MaxAgeSeconds < 0 {
+		return DataError{"max age cannot be negative"}
+	}
+	return nil
+}
+
+func (c CORSEntry) String() string {
+	return fmt.Sprintf("%s %s headers=%s maxAge=%ds", strings.Join(c.Origins, ","), strings.Join(c.Methods, ","),
+		strings.Join(c.ResponseHeaders, ","), c.MaxAgeSeconds)
+}
+
+func (b Bucket) GetCORS() []CORSEntry {
+	var entries []CORSEntry
+	for _, cors := range b.attrs.CORS {
+		entries = append(entries, CORSEntry{
+			Origins:         cors.Origins,
+			Methods:         cors.Methods,
+			ResponseHeaders: cors.ResponseHeaders,
+			MaxAgeSeconds:   int64(cors.MaxAge / time.Second),
+		})
+	}
+	return entries
+}
+
+func CORSToJSON(entries []CORSEntry) string {
+	if entries == nil {
+		entries = []CORSEntry{}
+	}
+	data, _ := json.MarshalIndent(entries, "", "  ")
+	return string(data)
+}
+
+func ParseCORSJSON(data string) ([]CORSEntry, error) {
+	var entries []CORSEntry
+	if err := json.Unmarshal([]byte(data), &entries); err != nil {
+		return nil, DataError{"invalid CORS JSON: " + err.Error()}
+	}
+	for i, entry := range entries {
+		if err := entry.Validate(); err != nil {
+			return nil, DataError{fmt.Sprintf("entry %d: %s", i+1, err.Error())}
+		}
+	}
+	return entries, nil
+}
+
+// Replace the CORS Configuration, failing if the Bucket changed since metageneration
+func UpdateCORS(bucket string, metageneration int64, entries []CORSEntry) error {
+	var cors = []storage.CORS{}
+	for _, entry := range entries {
+		cors = append(cors, storage.CORS{
+			Origins:         entry.Origins,
+			Methods:         entry.Methods,
+			ResponseHeaders: entry.ResponseHeaders,
+			MaxAge:          time.Duration(entry.MaxAgeSeconds) * time.Second,
+		})
+	}
+
+	return updateBucket(bucket, metageneration, storage.BucketAttrsToUpdate{CORS: cors})
+}
@@ -0,0 +1,71 @@
+package gcs
+
+import (
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+)
+
+// Object Counts of a Bucket including Noncurrent Versions
+type ObjectCount struct {
+	Live       int
+	Noncurrent int
+}
+
+func (oc ObjectCount) Total() int {
+	return oc.Live + oc.Noncurrent
+}
+
+func CountObjects(bucket string) (ObjectCount, error) {
+	var count ObjectCount
+
+	it := client.Bucket(bucket).Objects(ctx, &storage.Query{Versions: true})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return count, err
+		}
+		if attrs.Deleted.IsZero() {
+			count.Live += 1
+		} else {
+			count.Noncurrent += 1
+		}
+	}
+
+	return count, nil
+}
+
+// Delete every Object in the Bucket, all Versions included
+func EmptyBucket(bucket string) (int, error) {
+	var deleted = 0
+	var handle = client.Bucket(bucket)
+
+	it := handle.Objects(ctx, &storage.Query{Versions: true})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return deleted, err
+		}
+
+		// Already gone Objects are not counted as deleted
+		err = handle.Object(attrs.Name).Generation(attrs.Generation).Delete(ctx)
+		if err == storage.ErrObjectNotExist {
+			continue
+		}
+		if err != nil {
+			return deleted, err
+		}
+		deleted += 1
+	}
+
+	return deleted, nil
+}
+
+func DeleteBucket(bucket string) error {
+	return client.Bucket(bucket).Delete(ctx)
+}
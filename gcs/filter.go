@@ -0,0 +1,479 @@
+package gcs
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"cloud.google.com/go/storage"
+)
+
+var (
+	sizeUnits = map[string]int64{
+		"":    1,
+		"b":   1,
+		"kb":  1000,
+		"mb":  1000 * 1000,
+		"gb":  1000 * 1000 * 1000,
+		"tb":  1000 * 1000 * 1000 * 1000,
+		"kib": 1 << 10,
+		"mib": 1 << 20,
+		"gib": 1 << 30,
+		"tib": 1 << 40,
+	}
+
+	durationUnits = map[string]time.Duration{
+		"s": time.Second,
+		"m": time.Minute,
+		"h": time.Hour,
+		"d": 24 * time.Hour,
+		"w": 7 * 24 * time.Hour,
+		"y": 365 * 24 * time.Hour,
+	}
+
+	dateLayouts = []string{time.RFC3339, "2006-01-02T15:04", "2006-01-02"}
+
+	filterOperators = []string{"!=", ">=", "<=", "=", ">", "<"}
+
+	// Fields of a Filter, with their aliases
+	FilterFields = []string{"name", "size", "type", "class", "created", "updated", "age", "meta.<key>"}
+)
+
+// Expression over Object Attributes, such as
+//
+//	size > 1GiB and age > 90d
+//	type = image/* and meta.owner = ml
+//
+// Conditions are joined with and / or / not and grouped with parentheses.
+// Text globs match as in the search bar: * and ? stop at /, ** crosses it.
+type Filter struct {
+	expr string
+	root filterNode
+}
+
+type filterNode interface {
+	match(attrs *storage.ObjectAttrs, now time.Time) bool
+}
+
+type andNode struct{ left, right filterNode }
+
+type orNode struct{ left, right filterNode }
+
+type notNode struct{ node filterNode }
+
+type conditionNode struct {
+	field string
+	key   string
+	op    string
+	text  string
+	// Compiled text when it is a glob
+	glob *regexp.Regexp
+	size int64
+	age  time.Duration
+	date time.Time
+}
+
+func (n andNode) match(attrs *storage.ObjectAttrs, now time.Time) bool {
+	return n.left.match(attrs, now) && n.right.match(attrs, now)
+}
+
+func (n orNode) match(attrs *storage.ObjectAttrs, now time.Time) bool {
+	return n.left.match(attrs, now) || n.right.match(attrs, now)
+}
+
+func (n notNode) match(attrs *storage.ObjectAttrs, now time.Time) bool {
+	return !n.node.match(attrs, now)
+}
+
+// Whether the comparison result of the Attribute against the value satisfies op
+func satisfies(op string, result int) bool {
+	switch op {
+	case "=":
+		return result == 0
+	case "!=":
+		return result != 0
+	case ">":
+		return result > 0
+	case ">=":
+		return result >= 0
+	case "<":
+		return result < 0
+	case "<=":
+		return result <= 0
+	}
+	return false
+}
+
+// Equality on strings matches glob patterns such as image/*
+func (n conditionNode) matchText(value string) bool {
+	if n.op != "=" && n.op != "!=" {
+		return satisfies(n.op, cmp.Compare(value, n.text))
+	}
+
+	var equal = value == n.text
+	if n.glob != nil {
+		equal = n.glob.MatchString(value)
+	}
+
+	return equal == (n.op == "=")
+}
+
+func (n conditionNode) match(attrs *storage.ObjectAttrs, now time.Time) bool {
+	switch n.field {
+	case "name":
+		return n.matchText(attrs.Name)
+	case "type":
+		return n.matchText(attrs.ContentType)
+	case "class":
+		return n.matchText(strings.ToUpper(attrs.StorageClass))
+	case "meta":
+		return n.matchText(attrs.Metadata[n.key])
+	case "size":
+		return satisfies(n.op, cmp.Compare(attrs.Size, n.size))
+	case "age":
+		return satisfies(n.op, cmp.Compare(now.Sub(attrs.Updated), n.age))
+	case "created":
+		return satisfies(n.op, attrs.Created.Compare(n.date))
+	case "updated":
+		return satisfies(n.op, attrs.Updated.Compare(n.date))
+	}
+	return false
+}
+
+func ParseFilter(expr string) (*Filter, error) {
+	tokens, err := tokenizeFilter(expr)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, DataError{"Empty Filter"}
+	}
+
+	var p = filterParser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.tokens) {
+		return nil, DataError{"Unexpected " + p.tokens[p.pos]}
+	}
+
+	return &Filter{expr: strings.TrimSpace(expr), root: root}, nil
+}
+
+func (f *Filter) Match(attrs *storage.ObjectAttrs) bool {
+	return f.root.match(attrs, time.Now())
+}
+
+func (f *Filter) String() string {
+	return f.expr
+}
+
+// Split into words, quoted strings, parentheses and operators
+func tokenizeFilter(expr string) ([]string, error) {
+	var tokens []string
+	var runes = []rune(expr)
+
+	for i := 0; i < len(runes); {
+		var r = runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i += 1
+		case r == '(' || r == ')':
+			tokens = append(tokens, string(r))
+			i += 1
+		case r == '"' || r == '\'':
+			var end = i + 1
+			for end < len(runes) && runes[end] != r {
+				end += 1
+			}
+			if end == len(runes) {
+				return nil, DataError{"Unterminated quote in Filter"}
+			}
+			tokens = append(tokens, string(runes[i:end+1]))
+			i = end + 1
+		case strings.ContainsRune("!=<>", r):
+			var op = string(r)
+			if i+1 < len(runes) && runes[i+1] == '=' {
+				op += "="
+			}
+			if op == "!" {
+				return nil, DataError{"Unknown operator ! in Filter"}
+			}
+			tokens = append(tokens, op)
+			i += len(op)
+		default:
+			var end = i
+			for end < len(runes) && !unicode.IsSpace(runes[end]) && !strings.ContainsRune("()!=<>\"'", runes[end]) {
+				end += 1
+			}
+			tokens = append(tokens, string(runes[i:end]))
+			i = end
+		}
+	}
+
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *filterParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *filterParser) next() string {
+	var token = p.peek()
+	p.pos += 1
+	return token
+}
+
+func (p *filterParser) parseOr() (filterNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for strings.EqualFold(p.peek(), "or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left, right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filterNode, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for strings.EqualFold(p.peek(), "and") {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left, right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseNot() (filterNode, error) {
+	switch {
+	case strings.EqualFold(p.peek(), "not"):
+		p.next()
+		node, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{node}, nil
+	case p.peek() == "(":
+		p.next()
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != ")" {
+			return nil, DataError{"Missing ) in Filter"}
+		}
+		return node, nil
+	}
+	return p.parseCondition()
+}
+
+func (p *filterParser) parseCondition() (filterNode, error) {
+	var name, op, value = p.next(), p.next(), p.next()
+	var field = strings.ToLower(name)
+
+	if len(field) == 0 {
+		return nil, DataError{"Missing condition in Filter"}
+	}
+	if !isFilterOperator(op) {
+		return nil, DataError{fmt.Sprintf("Expected an operator after %s, got %q", field, op)}
+	}
+	if len(value) == 0 || value == "(" || value == ")" {
+		return nil, DataError{"Missing value after " + field + " " + op}
+	}
+	value = unquote(value)
+
+	var node = conditionNode{op: op}
+	var err error
+
+	switch {
+	case field == "name":
+		node.field, node.text = "name", value
+	case field == "type" || field == "contenttype":
+		node.field, node.text = "type", value
+	case field == "class" || field == "storageclass":
+		node.field, node.text = "class", strings.ToUpper(value)
+	case strings.HasPrefix(field, "meta.") || strings.HasPrefix(field, "metadata."):
+		_, key, _ := strings.Cut(name, ".")
+		node.field, node.key, node.text = "meta", key, value
+	case field == "size":
+		node.field = "size"
+		node.size, err = parseSize(value)
+	case field == "age":
+		node.field = "age"
+		node.age, err = parseAge(value)
+	case field == "created":
+		node.field = "created"
+		node.date, err = parseDate(value)
+	case field == "updated" || field == "modified":
+		node.field = "updated"
+		node.date, err = parseDate(value)
+	default:
+		return nil, DataError{fmt.Sprintf("Unknown field %s, expected one of %s", field, strings.Join(FilterFields, ", "))}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(node.text) != 0 && (op == "=" || op == "!=") && strings.ContainsAny(node.text, globMetaCharacters) {
+		if node.glob, err = compileGlob(node.text); err != nil {
+			return nil, err
+		}
+	}
+
+	return node, nil
+}
+
+// Regular Expression of a glob with the MatchGlob syntax of the search bar
+func compileGlob(glob string) (*regexp.Regexp, error) {
+	var sb strings.Builder
+	var alternatives = 0
+
+	sb.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; {
+		case c == '*' && strings.HasPrefix(glob[i:], "**"):
+			sb.WriteString(".*")
+			i += 1
+		case c == '*':
+			sb.WriteString("[^/]*")
+		case c == '?':
+			sb.WriteString("[^/]")
+		case c == '[':
+			var end = strings.IndexByte(glob[i+1:], ']')
+			if end == -1 {
+				return nil, DataError{"Unclosed [ in glob " + glob}
+			}
+			var class = glob[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			sb.WriteString("[" + class + "]")
+			i += end + 1
+		case c == '{':
+			sb.WriteString("(?:")
+			alternatives += 1
+		case c == ',' && alternatives != 0:
+			sb.WriteString("|")
+		case c == '}' && alternatives != 0:
+			sb.WriteString(")")
+			alternatives -= 1
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	sb.WriteString("$")
+
+	if alternatives != 0 {
+		return nil, DataError{"Unclosed { in glob " + glob}
+	}
+
+	expr, err := regexp.Compile(sb.String())
+	if err != nil {
+		return nil, DataError{"Invalid glob " + glob}
+	}
+	return expr, nil
+}
+
+func isFilterOperator(op string) bool {
+	for _, operator := range filterOperators {
+		if op == operator {
+			return true
+		}
+	}
+	return false
+}
+
+func unquote(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+// Split 1.5GiB into 1.5 and gib
+func splitUnit(value string) (float64, string, error) {
+	var index = strings.IndexFunc(value, func(r rune) bool {
+		return !unicode.IsDigit(r) && r != '.'
+	})
+	if index == -1 {
+		index = len(value)
+	}
+
+	number, err := strconv.ParseFloat(value[:index], 64)
+	if err != nil || number < 0 {
+		return 0, "", DataError{"Invalid number " + value}
+	}
+
+	return number, strings.ToLower(value[index:]), nil
+}
+
+// Sizes such as 512, 10MB or 1.5GiB
+func parseSize(value string) (int64, error) {
+	// Whole byte counts keep their precision beyond 2^53
+	if bytes, err := strconv.ParseInt(strings.TrimSuffix(strings.ToLower(value), "b"), 10, 64); err == nil && bytes >= 0 {
+		return bytes, nil
+	}
+
+	number, unit, err := splitUnit(value)
+	if err != nil {
+		return 0, err
+	}
+
+	multiplier, ok := sizeUnits[unit]
+	if !ok {
+		return 0, DataError{"Unknown size unit in " + value + ", expected B, KB, MB, GB, TB, KiB, MiB, GiB or TiB"}
+	}
+
+	return int64(number * float64(multiplier)), nil
+}
+
+// Ages such as 12h, 90d or 2w
+func parseAge(value string) (time.Duration, error) {
+	number, unit, err := splitUnit(value)
+	if err != nil {
+		return 0, err
+	}
+
+	multiplier, ok := durationUnits[unit]
+	if !ok {
+		return 0, DataError{"Unknown age unit in " + value + ", expected s, m, h, d, w or y"}
+	}
+
+	return time.Duration(number * float64(multiplier)), nil
+}
+
+// Dates such as 2024-01-31, in local time unless a zone is given
+func parseDate(value string) (time.Time, error) {
+	for _, layout := range dateLayouts {
+		if date, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, DataError{"Invalid date " + value + ", expected YYYY-MM-DD"}
+}
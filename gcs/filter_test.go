@@ -0,0 +1,113 @@
+package gcs
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+)
+
+func TestParseFilterMatch(t *testing.T) {
+	var attrs = &storage.ObjectAttrs{
+		Name:         "logs/2024/a b.csv",
+		Size:         2 << 30,
+		ContentType:  "text/csv",
+		StorageClass: "NEARLINE",
+		Created:      time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		Updated:      time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC),
+		Metadata:     map[string]string{"Owner": "ml"},
+	}
+
+	var tests = []struct {
+		expr string
+		want bool
+	}{
+		{"size > 1GiB", true},
+		{"size >= 2147483648", true},
+		{"size < 2GB", false},
+		{"type = text/*", true},
+		{"class = nearline", true},
+		{"age > 90d", true},
+		{"created < 2021-01-01", true},
+		{"updated > 2020-07-01", false},
+		{"meta.Owner = ml", true},
+		{"meta.owner = ml", false},
+
+		// and binds tighter than or, not tighter than and
+		{"size < 1KB and type = text/csv or class = NEARLINE", true},
+		{"size < 1KB and (type = text/csv or class = NEARLINE)", false},
+		{"not size < 1KB and class = NEARLINE", true},
+		{"not (size > 1KB and class = NEARLINE)", false},
+		{"NOT size < 1KB AND class = STANDARD OR name = x", false},
+
+		// Quoted values keep their spaces
+		{`name = "logs/2024/a b.csv"`, true},
+		{`name = 'logs/2024/a b.csv'`, true},
+
+		// Globs match as in the search bar
+		{"name = logs/*", false},
+		{"name = logs/**", true},
+		{"name = logs/*/*.csv", true},
+		{"name = logs/202?/*.{csv,json}", true},
+		{"name = logs/[0-9]*/*", true},
+		{"name != **.json", true},
+	}
+
+	for _, test := range tests {
+		filter, err := ParseFilter(test.expr)
+		if err != nil {
+			t.Errorf("ParseFilter(%q) failed: %v", test.expr, err)
+			continue
+		}
+		if got := filter.Match(attrs); got != test.want {
+			t.Errorf("ParseFilter(%q).Match() = %v, want %v", test.expr, got, test.want)
+		}
+	}
+}
+
+func TestParseFilterErrors(t *testing.T) {
+	var tests = []string{
+		"",
+		"size",
+		"size >",
+		"size > 10XB",
+		"size > -1",
+		"age > 3 weeks",
+		"created > yesterday",
+		"colour = red",
+		"name ! x",
+		`name = "unterminated`,
+		"(size > 1KB",
+		"size > 1KB and",
+		"name = logs/[0-9",
+		"name = logs/{a,b",
+	}
+
+	for _, expr := range tests {
+		if _, err := ParseFilter(expr); err == nil {
+			t.Errorf("ParseFilter(%q) succeeded, want an error", expr)
+		}
+	}
+}
+
+func TestParseSize(t *testing.T) {
+	var tests = []struct {
+		value string
+		want  int64
+	}{
+		{"512", 512},
+		{"512B", 512},
+		{"1.5KB", 1500},
+		{"1KiB", 1024},
+		{"2gib", 2 << 30},
+		// Beyond the precision of float64
+		{"9007199254740993", 9007199254740993},
+	}
+
+	for _, test := range tests {
+		got, err := parseSize(test.value)
+		if err != nil || got != test.want {
+			t.Errorf("parseSize(%q) = %d, %v, want %d", test.value, got, err, test.want)
+		}
+	}
+}
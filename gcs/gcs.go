@@ -3,36 +3,15 @@ package gcs
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strings"
+	"time"
 
 	"cloud.google.com/go/storage"
-	"github.com/charmbracelet/bubbles/table"
-	"github.com/charmbracelet/lipgloss"
 	"google.golang.org/api/iterator"
 )
 
 var (
-	fieldStyle = lipgloss.NewStyle().Bold(true)
-	valueStyle = lipgloss.NewStyle()
-	linkStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("#3367D6")).Underline(true).Italic(true)
-
-	bucketCols = []table.Column{
-		{Title: "Name", Width: 40},
-		{Title: "Created", Width: 20},
-		{Title: "LocationType", Width: 15},
-		{Title: "Location", Width: 10},
-		{Title: "DefaultStorageClass", Width: 20},
-	}
-
-	objectCols = []table.Column{
-		{Title: "Name", Width: 40},
-		{Title: "Size", Width: 10},
-		{Title: "Type", Width: 10},
-		{Title: "Storage Class", Width: 15},
-		{Title: "Created", Width: 20},
-		{Title: "Last Modified", Width: 20},
-	}
-
 	projectId string
 	ctx       context.Context
 	client    *storage.Client
@@ -77,109 +56,96 @@ func Init(_projectId string) error {
 	return err
 }
 
+// Bucket of the Project, presented from its Attributes
 type Bucket struct {
-	name                string
-	created             string
-	locationType        string
-	location            string
-	defaultStorageClass string
-	lastModified        string
-	publicAccess        string
-	accessControl       string
-	protection          string
-	bucketRetention     string
-	lifeCycleRules      string
-	tags                string
-	encryption          string
-	labels              string
-	requesterPays       string
-	replication         string
+	attrs *storage.BucketAttrs
+}
+
+func newBucket(attrs *storage.BucketAttrs) *Bucket {
+	return &Bucket{attrs: attrs}
 }
 
 func (b Bucket) GetName() string {
-	return b.name
+	return b.attrs.Name
 }
 
-func renderFieldValue(field, value string) string {
-	return lipgloss.NewStyle().Render(fieldStyle.Render(field) + " " + valueStyle.Render(value) + "\n")
+func (b Bucket) GetAttrs() *storage.BucketAttrs {
+	return b.attrs
 }
 
-func renderFieldHref(field, href string) string {
-	return lipgloss.NewStyle().Render(fieldStyle.Render(field) + " " + linkStyle.Render(href) + "\n")
+func (b Bucket) GetCreated() time.Time {
+	return b.attrs.Created
 }
 
-func renderIndent(indent int, str string) string {
-	return strings.Repeat("\t", indent) + str
+func (b Bucket) GetURI() string {
+	return "gs://" + b.attrs.Name
 }
 
-func (b Bucket) DisplayString() string {
-	var sb strings.Builder
+func (b Bucket) GetAuthenticatedURL() string {
+	return storageURL("storage.cloud.google.com", b.attrs.Name)
+}
 
-	sb.WriteString(renderFieldValue("Last Modified:", b.lastModified))
-	sb.WriteString(renderFieldValue("Public Access:", b.publicAccess))
-	sb.WriteString(renderFieldValue("Access Control:", b.accessControl))
-	sb.WriteString(renderFieldValue("Protection:", b.protection))
-	sb.WriteString(renderFieldValue("Bucket Retention:", b.bucketRetention))
-	sb.WriteString(renderFieldValue("Life Cycle Rules:", b.lifeCycleRules))
-	sb.WriteString(renderFieldValue("Tags:", b.tags))
-	sb.WriteString(renderFieldValue("Encryption:", b.encryption))
-	sb.WriteString(renderFieldValue("Labels:", b.labels))
-	sb.WriteString(renderFieldValue("Requester Pays:", b.requesterPays))
-	sb.WriteString(renderFieldValue("Replication:", b.replication))
+func (b Bucket) GetPublicURL() string {
+	return storageURL("storage.googleapis.com", b.attrs.Name)
+}
 
-	return sb.String()
+// URL of the path on host, with names such as "a b#1.txt" escaped
+func storageURL(host, path string) string {
+	var u = url.URL{Scheme: "https", Host: host, Path: "/" + path}
+	return u.String()
 }
 
+// Object of a Bucket, presented from its Attributes
 type Object struct {
-	name              string
-	size              string
-	objectType        string
-	created           string
-	lastModified      string
-	storageClass      string
-	customTime        string
-	publicURL         string
-	authenticatedURL  string
-	gsutilURI         string
-	publicAccess      string
-	versionHistory    string
-	objectRetainUntil string
-	bucketRetainUntil string
-	holdStatus        string
-	encryptionType    string
+	attrs *storage.ObjectAttrs
+}
+
+func newObject(attrs *storage.ObjectAttrs) *Object {
+	return &Object{attrs: attrs}
 }
 
 func (o Object) GetName() string {
-	return o.name
+	return o.attrs.Name
 }
 
-func (o Object) DisplayString() string {
-	var sb strings.Builder
+func (o Object) GetBucketName() string {
+	return o.attrs.Bucket
+}
 
-	var currentIndent = 0
-	sb.WriteString(renderFieldValue("Overview", ""))
-	currentIndent += 1
-	sb.WriteString(renderIndent(currentIndent, renderFieldValue("Custom Time:", o.customTime)))
-	sb.WriteString(renderIndent(currentIndent, renderFieldHref("Public URL:", o.publicURL)))
-	sb.WriteString(renderIndent(currentIndent, renderFieldHref("Authenticated URL:", o.authenticatedURL)))
-	sb.WriteString(renderIndent(currentIndent, renderFieldHref("gsutil URI:", o.gsutilURI)))
-	currentIndent -= 1
+func (o Object) GetAttrs() *storage.ObjectAttrs {
+	return o.attrs
+}
 
-	sb.WriteString(renderFieldValue("Permissions", ""))
+func (o Object) GetSize() int64 {
+	return o.attrs.Size
+}
 
-	sb.WriteString(renderIndent(currentIndent+1, renderFieldValue("Public Access:", o.publicAccess)))
+func (o Object) GetContentType() string {
+	return o.attrs.ContentType
+}
 
-	sb.WriteString(renderFieldValue("Protection", ""))
-	currentIndent += 1
-	sb.WriteString(renderIndent(currentIndent, renderFieldValue("Version History:", o.versionHistory)))
-	sb.WriteString(renderIndent(currentIndent, renderFieldValue("Retention Expiration Time", "")))
-	sb.WriteString(renderIndent(currentIndent+1, renderFieldValue("Object Retention retain until time:", o.objectRetainUntil)))
-	sb.WriteString(renderIndent(currentIndent+1, renderFieldValue("Bucket Retention retain until time:", o.bucketRetainUntil)))
-	sb.WriteString(renderIndent(currentIndent, renderFieldValue("Hold Status:", o.holdStatus)))
-	sb.WriteString(renderIndent(currentIndent, renderFieldValue("Encryption Type:", o.encryptionType)))
-	currentIndent -= 1
+func (o Object) GetStorageClass() string {
+	return o.attrs.StorageClass
+}
 
-	return sb.String()
+func (o Object) GetCreated() time.Time {
+	return o.attrs.Created
+}
+
+func (o Object) GetUpdated() time.Time {
+	return o.attrs.Updated
+}
+
+func (o Object) GetURI() string {
+	return fmt.Sprintf("gs://%s/%s", o.attrs.Bucket, o.attrs.Name)
+}
+
+func (o Object) GetAuthenticatedURL() string {
+	return storageURL("storage.cloud.google.com", o.attrs.Bucket+"/"+o.attrs.Name)
+}
+
+func (o Object) GetPublicURL() string {
+	return storageURL("storage.googleapis.com", o.attrs.Bucket+"/"+o.attrs.Name)
 }
 
 type DataError struct {
@@ -194,7 +160,11 @@ type Data struct {
 	IsBucket bool
 	buckets  []*Bucket
 	objects  []*Object
-	err      error
+	// Bucket of the listed Objects
+	bucket *Bucket
+	// Objects listed across Buckets
+	global bool
+	err    error
 }
 
 func (data Data) GetError() error {
@@ -218,45 +188,31 @@ func (data Data) GetObject(index int) *Object {
 	return data.objects[index]
 }
 
-func (data Data) GetTableData() ([]table.Column, []table.Row) {
+// Number of listed Buckets or Objects
+func (data Data) Len() int {
 	if data.IsBucket {
-		return bucketCols, convertBucketToRows(data.buckets)
-	} else {
-		return objectCols, convertObjectToRows(data.objects)
+		return len(data.buckets)
 	}
+	return len(data.objects)
 }
 
-func convertBucketToRows(buckets []*Bucket) []table.Row {
-	var rows []table.Row
-
-	for _, bucket := range buckets {
-		rows = append(rows, table.Row{bucket.name, bucket.created, bucket.locationType, bucket.location, bucket.defaultStorageClass})
-	}
-
-	return rows
+// Whether the Objects are listed across Buckets
+func (data Data) IsGlobal() bool {
+	return data.global
 }
 
-func convertObjectToRows(objects []*Object) []table.Row {
-	var rows []table.Row
-
-	for _, object := range objects {
-		rows = append(rows, table.Row{object.name, object.size, object.objectType, object.storageClass, object.created, object.lastModified})
-	}
-
-	return rows
+// Bucket of the listed Objects, nil when listing Buckets
+func (data Data) GetParentBucket() *Bucket {
+	return data.bucket
 }
 
-func listToString(list []string, sep string) string {
-	var sb strings.Builder
-
-	for i, item := range list {
-		sb.WriteString(item)
-		if i != len(list)-1 {
-			sb.WriteString(sep)
-		}
+// Whether name is an Object of bucket rather than a prefix
+func IsObject(bucket, name string) (bool, error) {
+	_, err := client.Bucket(bucket).Object(name).Attrs(ctx)
+	if err == storage.ErrObjectNotExist {
+		return false, nil
 	}
-
-	return sb.String()
+	return err == nil, err
 }
 
 func GetData(path string) *Data {
@@ -270,9 +226,9 @@ func GetData(path string) *Data {
 				break
 			}
 			if err != nil {
-				// return err TODO
+				return &Data{err: err}
 			} else {
-				bucket := getBucket(bucketAttrs)
+				bucket := newBucket(bucketAttrs)
 				buckets = append(buckets, bucket)
 			}
 		}
@@ -290,16 +246,14 @@ func GetData(path string) *Data {
 		bucket = path[:index]
 	}
 
-	_, err := client.Bucket(bucket).Attrs(ctx)
+	bucketAttrs, err := client.Bucket(bucket).Attrs(ctx)
 
 	if err == storage.ErrBucketNotExist {
-		// return &Data{err: DataError{"Bucket Not Found - " + bucket}}
-		return nil
+		return &Data{err: DataError{"Bucket Not Found - " + bucket}}
 	}
 
 	if err != nil {
-		// Handle Error TODO
-		return nil
+		return &Data{err: err}
 	}
 
 	var prefix string = ""
@@ -320,103 +274,14 @@ func GetData(path string) *Data {
 			break
 		}
 		if err != nil {
-			// TODO: log.Fatal(err)
-		} else {
-			object := newFunction(attrs)
-			objects = append(objects, object)
+			return &Data{err: err}
 		}
+		objects = append(objects, newObject(attrs))
 	}
 
 	if len(objects) != 0 {
-		return &Data{IsBucket: false, objects: objects}
+		return &Data{IsBucket: false, objects: objects, bucket: newBucket(bucketAttrs)}
 	}
 
 	return nil
 }
-
-func newFunction(attrs *storage.ObjectAttrs) *Object {
-	var authenticatedURL string = fmt.Sprintf("https://storage.cloud.google.com/%s/%s", attrs.Bucket, attrs.Name)
-	var gsutilURI string = fmt.Sprintf("gs://%s/%s", attrs.Bucket, attrs.Name)
-	var encryption string = "Google Managed"
-
-	if len(attrs.CustomerKeySHA256) != 0 {
-		encryption = attrs.CustomerKeySHA256
-	} else if len(attrs.KMSKeyName) != 0 {
-		encryption = attrs.KMSKeyName
-	}
-
-	object := &Object{
-		name:             attrs.Name,
-		size:             fmt.Sprint(attrs.Size),
-		objectType:       attrs.ContentType,
-		created:          attrs.Created.String(),
-		lastModified:     attrs.Updated.String(),
-		storageClass:     attrs.StorageClass,
-		customTime:       attrs.CustomTime.String(),
-		publicURL:        "NA",
-		authenticatedURL: authenticatedURL,
-		gsutilURI:        gsutilURI,
-		// publicAccess      string
-		// versionHistory    string
-		// objectRetainUntil string
-		// bucketRetainUntil string
-		// holdStatus        string
-		encryptionType: encryption,
-	}
-	return object
-}
-
-func getBucket(bucketAttrs *storage.BucketAttrs) *Bucket {
-	var accessControl string = "Uniform"
-	var protection string = "None"
-	var bucketRetention = "None"
-	var lifeCycleRules string = "None"
-	var tags []string
-	var labels []string
-	var requesterPays = "OFF"
-	var encryption = "Google Managed"
-
-	if !bucketAttrs.UniformBucketLevelAccess.Enabled {
-		accessControl = "Fine Grained"
-	}
-
-	if bucketAttrs.VersioningEnabled {
-		protection = "Versioning"
-	}
-
-	if len(bucketAttrs.Lifecycle.Rules) != 0 {
-		lifeCycleRules = fmt.Sprint(len(bucketAttrs.Lifecycle.Rules), " Rules")
-	}
-
-	for k, v := range bucketAttrs.Labels {
-		labels = append(labels, k+": "+v)
-	}
-
-	if bucketAttrs.RequesterPays {
-		requesterPays = "ON"
-	}
-
-	if bucketAttrs.Encryption != nil {
-		encryption = bucketAttrs.Encryption.DefaultKMSKeyName
-	}
-
-	bucket := &Bucket{
-		name:                bucketAttrs.Name,
-		created:             bucketAttrs.Created.String(),
-		locationType:        bucketAttrs.LocationType,
-		location:            bucketAttrs.Location,
-		defaultStorageClass: bucketAttrs.StorageClass,
-		lastModified:        "NA",
-		publicAccess:        bucketAttrs.PublicAccessPrevention.String(),
-		accessControl:       accessControl,
-		protection:          protection,
-		bucketRetention:     bucketRetention,
-		lifeCycleRules:      lifeCycleRules,
-		tags:                listToString(tags, ","),
-		encryption:          encryption,
-		labels:              listToString(labels, ","),
-		requesterPays:       requesterPays,
-		replication:         bucketAttrs.RPO.String(),
-	}
-	return bucket
-}
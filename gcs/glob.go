@@ -0,0 +1,254 @@
+package gcs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+)
+
+var (
+	// Most Objects handed to the UI at once
+	globBatchSize = 200
+
+	// Buckets listed at once by a Global Search
+	globalSearchWorkers = 8
+
+	globMetaCharacters = "*?[{"
+)
+
+// Listing of the Objects matching a Glob (or prefix) and an optional Filter,
+// streamed in the background.
+// A Global Search has no bucket and lists every Bucket of the Project.
+type GlobSearch struct {
+	bucket  string
+	glob    string
+	filter  *Filter
+	results chan *Object
+	cancel  context.CancelFunc
+	lock    sync.Mutex
+	err     error
+	failed  int
+}
+
+// MatchGlob when the pattern has glob metacharacters, else a Prefix
+func patternQuery(pattern string) *storage.Query {
+	if strings.ContainsAny(pattern, globMetaCharacters) {
+		return &storage.Query{MatchGlob: pattern}
+	}
+	return &storage.Query{Prefix: pattern}
+}
+
+func newGlobSearch(bucket, glob string, filter *Filter) (*GlobSearch, context.Context) {
+	searchCtx, cancel := context.WithCancel(ctx)
+	return &GlobSearch{bucket: bucket, glob: glob, filter: filter, results: make(chan *Object, globBatchSize), cancel: cancel}, searchCtx
+}
+
+// Stream the Objects of bucket matching the query, stopping when cancelled
+func (s *GlobSearch) listBucket(searchCtx context.Context, bucket string, query *storage.Query) {
+	it := client.Bucket(bucket).Objects(searchCtx, query)
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			return
+		}
+		if err != nil {
+			if searchCtx.Err() == nil {
+				s.fail(err)
+			}
+			return
+		}
+		if s.filter != nil && !s.filter.Match(attrs) {
+			continue
+		}
+		select {
+		case s.results <- newObject(attrs):
+		case <-searchCtx.Done():
+			return
+		}
+	}
+}
+
+func (s *GlobSearch) fail(err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.err == nil {
+		s.err = err
+	}
+	s.failed += 1
+}
+
+// Search the Objects of bucket by glob or prefix, filter may be nil
+func StartGlobSearch(bucket, glob string, filter *Filter) *GlobSearch {
+	search, searchCtx := newGlobSearch(bucket, glob, filter)
+
+	go func() {
+		defer close(search.results)
+		search.listBucket(searchCtx, bucket, patternQuery(glob))
+	}()
+
+	return search
+}
+
+// Search the Object Names of every Bucket in the Project, by glob or prefix
+func StartGlobalSearch(pattern string, filter *Filter) *GlobSearch {
+	search, searchCtx := newGlobSearch("", pattern, filter)
+
+	go func() {
+		defer close(search.results)
+
+		var wg sync.WaitGroup
+		var workers = make(chan struct{}, globalSearchWorkers)
+
+		// Every Bucket is searched, unlike the capped Bucket Names of completion
+		it := client.Buckets(searchCtx, projectId)
+		for {
+			attrs, err := it.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				if searchCtx.Err() == nil {
+					search.fail(err)
+				}
+				break
+			}
+
+			var bucket = attrs.Name
+			select {
+			case workers <- struct{}{}:
+			case <-searchCtx.Done():
+				wg.Wait()
+				return
+			}
+
+			wg.Add(1)
+			go func(bucket string) {
+				defer wg.Done()
+				defer func() { <-workers }()
+				search.listBucket(searchCtx, bucket, patternQuery(pattern))
+			}(bucket)
+		}
+
+		wg.Wait()
+	}()
+
+	return search
+}
+
+func (s *GlobSearch) IsGlobal() bool {
+	return len(s.bucket) == 0
+}
+
+func (s *GlobSearch) GetBucket() string {
+	return s.bucket
+}
+
+func (s *GlobSearch) GetGlob() string {
+	return s.glob
+}
+
+// Filter of the Search, nil when only matching names
+func (s *GlobSearch) GetFilter() *Filter {
+	return s.filter
+}
+
+// Wait for the next Objects, false once the Search is done
+func (s *GlobSearch) Next() ([]*Object, bool) {
+	object, ok := <-s.results
+	if !ok {
+		return nil, false
+	}
+
+	var batch = []*Object{object}
+	for len(batch) < globBatchSize {
+		select {
+		case object, ok := <-s.results:
+			if !ok {
+				return batch, true
+			}
+			batch = append(batch, object)
+		default:
+			return batch, true
+		}
+	}
+
+	return batch, true
+}
+
+// Error that ended the Search, valid once Next returned false
+func (s *GlobSearch) Err() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.IsGlobal() && s.failed != 0 {
+		return DataError{fmt.Sprintf("%d buckets failed, first error: %v", s.failed, s.err)}
+	}
+	return s.err
+}
+
+func (s *GlobSearch) Cancel() {
+	s.cancel()
+}
+
+// Empty Object Listing of a Bucket, filled by a Search
+func NewObjectData(bucket string) (*Data, error) {
+	bucketAttrs, err := client.Bucket(bucket).Attrs(ctx)
+	if err == storage.ErrBucketNotExist {
+		return nil, DataError{"Bucket Not Found - " + bucket}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &Data{IsBucket: false, objects: []*Object{}, bucket: newBucket(bucketAttrs)}, nil
+}
+
+// Empty Object Listing across Buckets, filled by a Global Search
+func NewGlobalData() *Data {
+	return &Data{IsBucket: false, objects: []*Object{}, global: true}
+}
+
+func (data *Data) AppendObjects(objects []*Object) {
+	data.objects = append(data.objects, objects...)
+}
+
+// Fetch the Objects at the indices again, dropping those no longer found.
+// Other failures leave the Object as listed, the first of them is returned.
+func (data *Data) ReloadObjects(indices []int) error {
+	var removed = make(map[int]bool)
+	var firstErr error
+
+	for _, index := range indices {
+		var object = data.GetObject(index)
+		if object == nil {
+			continue
+		}
+
+		attrs, err := client.Bucket(object.GetBucketName()).Object(object.GetName()).Attrs(ctx)
+		switch {
+		case err == storage.ErrObjectNotExist:
+			removed[index] = true
+		case err != nil && firstErr == nil:
+			firstErr = err
+		case err == nil:
+			data.objects[index] = newObject(attrs)
+		}
+	}
+
+	if len(removed) != 0 {
+		var kept []*Object
+		for i, object := range data.objects {
+			if !removed[i] {
+				kept = append(kept, object)
+			}
+		}
+		data.objects = kept
+	}
+
+	return firstErr
+}
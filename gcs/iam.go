@@ -0,0 +1,178 @@
+package gcs
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"cloud.google.com/go/iam"
+	"cloud.google.com/go/iam/apiv1/iampb"
+	"cloud.google.com/go/storage"
+	"google.golang.org/genproto/googleapis/type/expr"
+)
+
+var (
+	ObjectACLRoles = []string{string(storage.RoleReader), string(storage.RoleOwner)}
+
+	memberPrefixes = []string{"user:", "serviceAccount:", "group:", "domain:", "projectOwner:",
+		"projectEditor:", "projectViewer:", "principal://", "principalSet://"}
+)
+
+// Member granted a Role, optionally under a Condition
+type IAMBinding struct {
+	Role      string
+	Member    string
+	Title     string
+	Condition string
+	// Original Condition, keeps its Description
+	condition *expr.Expr
+}
+
+func (b IAMBinding) String() string {
+	if len(b.Condition) == 0 {
+		return b.Role + " " + b.Member
+	}
+	return fmt.Sprintf("%s %s if %s (%s)", b.Role, b.Member, b.Title, b.Condition)
+}
+
+func (b IAMBinding) Validate() error {
+	if !strings.HasPrefix(b.Role, "roles/") && !strings.HasPrefix(b.Role, "projects/") && !strings.HasPrefix(b.Role, "organizations/") {
+		return DataError{fmt.Sprintf("role %q must start with roles/", b.Role)}
+	}
+	if len(b.Condition) != 0 && len(b.Title) == 0 {
+		return DataError{"a condition needs a title"}
+	}
+	if b.Member == "allUsers" || b.Member == "allAuthenticatedUsers" {
+		return nil
+	}
+	for _, prefix := range memberPrefixes {
+		if strings.HasPrefix(b.Member, prefix) && len(b.Member) > len(prefix) {
+			return nil
+		}
+	}
+	return DataError{fmt.Sprintf("member %q must look like user:email, group:email, serviceAccount:email, domain:name or allUsers", b.Member)}
+}
+
+// Condition to be written, nil for unconditional Bindings
+func (b IAMBinding) toExpr() *expr.Expr {
+	if len(b.Condition) == 0 {
+		return nil
+	}
+	if b.condition != nil && b.condition.Expression == b.Condition && b.condition.Title == b.Title {
+		return b.condition
+	}
+	return &expr.Expr{Title: b.Title, Expression: b.Condition}
+}
+
+// IAM Policy (version 3) of a Bucket, its Etag guards against concurrent changes
+type IAMPolicy struct {
+	Bindings []IAMBinding
+	policy   *iam.Policy3
+}
+
+func GetIAMPolicy(bucket string) (*IAMPolicy, error) {
+	policy, err := client.Bucket(bucket).IAM().V3().Policy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var bindings []IAMBinding
+	for _, binding := range policy.Bindings {
+		for _, member := range binding.Members {
+			var b = IAMBinding{Role: binding.Role, Member: member, condition: binding.Condition}
+			if binding.Condition != nil {
+				b.Title = binding.Condition.Title
+				b.Condition = binding.Condition.Expression
+			}
+			bindings = append(bindings, b)
+		}
+	}
+
+	// Group Members by Role
+	sort.SliceStable(bindings, func(i, j int) bool {
+		return bindings[i].Role < bindings[j].Role
+	})
+
+	return &IAMPolicy{Bindings: bindings, policy: policy}, nil
+}
+
+// Replace the Bindings of the Policy, failing if it changed since it was read
+func SetIAMPolicy(bucket string, policy *IAMPolicy, bindings []IAMBinding) error {
+	var grouped []*iampb.Binding
+	var index = make(map[string]*iampb.Binding)
+
+	for _, b := range bindings {
+		var condition = b.toExpr()
+		var key = b.Role + "\x00" + b.Title + "\x00" + b.Condition
+
+		binding, ok := index[key]
+		if !ok {
+			binding = &iampb.Binding{Role: b.Role, Condition: condition}
+			index[key] = binding
+			grouped = append(grouped, binding)
+		}
+		binding.Members = append(binding.Members, b.Member)
+	}
+
+	policy.policy.Bindings = grouped
+
+	return client.Bucket(bucket).IAM().V3().SetPolicy(ctx, policy.policy)
+}
+
+// Access Control List entry of an Object
+type ACLRule struct {
+	Entity string
+	Role   string
+}
+
+func (r ACLRule) String() string {
+	return r.Entity + " " + r.Role
+}
+
+func GetObjectACL(bucket, object string) ([]ACLRule, error) {
+	rules, err := client.Bucket(bucket).Object(object).ACL().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var acl []ACLRule
+	for _, rule := range rules {
+		acl = append(acl, ACLRule{Entity: string(rule.Entity), Role: string(rule.Role)})
+	}
+
+	return acl, nil
+}
+
+// Set the changed Entities and delete the removed ones
+func UpdateObjectACL(bucket, object string, old, new []ACLRule) error {
+	var handle = client.Bucket(bucket).Object(object).ACL()
+	var roles = make(map[string]string)
+
+	for _, rule := range new {
+		roles[rule.Entity] = rule.Role
+	}
+
+	for _, rule := range old {
+		if _, ok := roles[rule.Entity]; !ok {
+			if err := handle.Delete(ctx, storage.ACLEntity(rule.Entity)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, rule := range new {
+		var changed = true
+		for _, oldRule := range old {
+			if oldRule == rule {
+				changed = false
+			}
+		}
+		if changed {
+			if err := handle.Set(ctx, storage.ACLEntity(rule.Entity), storage.ACLRole(rule.Role)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
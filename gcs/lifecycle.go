@@ -0,0 +1,176 @@
+package gcs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+)
+
+var (
+	LifecycleActions = []string{storage.DeleteAction, storage.SetStorageClassAction, storage.AbortIncompleteMPUAction}
+
+	ObjectStates = []string{"Any", "Live", "Noncurrent"}
+
+	dateLayout = "2006-01-02"
+)
+
+// Lifecycle Rule with the Conditions editable from the Dialog
+type LifecycleRule struct {
+	Action                string
+	StorageClass          string
+	AgeInDays             int64
+	CreatedBefore         string
+	MatchesPrefix         []string
+	MatchesSuffix         []string
+	NumNewerVersions      int64
+	MatchesStorageClasses []string
+	ObjectState           string
+	// Original Rule, keeps the Conditions not edited here
+	raw storage.LifecycleRule
+}
+
+func fromStorageRule(rule storage.LifecycleRule) LifecycleRule {
+	var createdBefore = ""
+	if !rule.Condition.CreatedBefore.IsZero() {
+		createdBefore = rule.Condition.CreatedBefore.Format(dateLayout)
+	}
+
+	return LifecycleRule{
+		Action:                rule.Action.Type,
+		StorageClass:          rule.Action.StorageClass,
+		AgeInDays:             rule.Condition.AgeInDays,
+		CreatedBefore:         createdBefore,
+		MatchesPrefix:         rule.Condition.MatchesPrefix,
+		MatchesSuffix:         rule.Condition.MatchesSuffix,
+		NumNewerVersions:      rule.Condition.NumNewerVersions,
+		MatchesStorageClasses: rule.Condition.MatchesStorageClasses,
+		ObjectState:           ObjectStates[rule.Condition.Liveness],
+		raw:                   rule,
+	}
+}
+
+func (r LifecycleRule) toStorageRule() storage.LifecycleRule {
+	var rule = r.raw
+	var liveness = storage.LiveAndArchived
+
+	for i, state := range ObjectStates {
+		if state == r.ObjectState {
+			liveness = storage.Liveness(i)
+		}
+	}
+
+	rule.Action = storage.LifecycleAction{Type: r.Action}
+	if r.Action == storage.SetStorageClassAction {
+		rule.Action.StorageClass = r.StorageClass
+	}
+
+	rule.Condition.AgeInDays = r.AgeInDays
+	rule.Condition.CreatedBefore, _ = time.Parse(dateLayout, r.CreatedBefore)
+	rule.Condition.MatchesPrefix = r.MatchesPrefix
+	rule.Condition.MatchesSuffix = r.MatchesSuffix
+	rule.Condition.NumNewerVersions = r.NumNewerVersions
+	rule.Condition.MatchesStorageClasses = r.MatchesStorageClasses
+	rule.Condition.Liveness = liveness
+
+	return rule
+}
+
+// Copy of the Rule to be edited, keeping the Conditions not edited here
+func (r LifecycleRule) Edit() LifecycleRule {
+	return LifecycleRule{raw: r.raw}
+}
+
+func (r LifecycleRule) Validate() error {
+	if r.Action == storage.SetStorageClassAction && len(r.StorageClass) == 0 {
+		return DataError{"SetStorageClass requires a storage class"}
+	}
+	if r.AgeInDays < 0 || r.NumNewerVersions < 0 {
+		return DataError{"age and newer versions cannot be negative"}
+	}
+	if len(r.CreatedBefore) != 0 {
+		if _, err := time.Parse(dateLayout, r.CreatedBefore); err != nil {
+			return DataError{"created before must be YYYY-MM-DD"}
+		}
+	}
+	if len(r.ConditionString()) == 0 {
+		return DataError{"rule needs at least one condition"}
+	}
+	return nil
+}
+
+func (r LifecycleRule) ActionString() string {
+	if r.Action == storage.SetStorageClassAction {
+		return r.Action + " " + r.StorageClass
+	}
+	return r.Action
+}
+
+func (r LifecycleRule) ConditionString() string {
+	var conditions []string
+
+	if r.AgeInDays != 0 {
+		conditions = append(conditions, fmt.Sprintf("age %dd", r.AgeInDays))
+	}
+	if len(r.CreatedBefore) != 0 {
+		conditions = append(conditions, "created before "+r.CreatedBefore)
+	}
+	if len(r.MatchesPrefix) != 0 {
+		conditions = append(conditions, "prefix "+strings.Join(r.MatchesPrefix, ","))
+	}
+	if len(r.MatchesSuffix) != 0 {
+		conditions = append(conditions, "suffix "+strings.Join(r.MatchesSuffix, ","))
+	}
+	if r.NumNewerVersions != 0 {
+		conditions = append(conditions, fmt.Sprintf("%d newer versions", r.NumNewerVersions))
+	}
+	if len(r.MatchesStorageClasses) != 0 {
+		conditions = append(conditions, "class "+strings.Join(r.MatchesStorageClasses, ","))
+	}
+	if r.ObjectState != ObjectStates[0] && len(r.ObjectState) != 0 {
+		conditions = append(conditions, strings.ToLower(r.ObjectState))
+	}
+
+	// Conditions kept from the original Rule
+	var other = r.raw.Condition
+	if other.AllObjects {
+		conditions = append(conditions, "all objects")
+	}
+	if other.DaysSinceCustomTime != 0 {
+		conditions = append(conditions, fmt.Sprintf("%dd since custom time", other.DaysSinceCustomTime))
+	}
+	if !other.CustomTimeBefore.IsZero() {
+		conditions = append(conditions, "custom time before "+other.CustomTimeBefore.Format(dateLayout))
+	}
+	if other.DaysSinceNoncurrentTime != 0 {
+		conditions = append(conditions, fmt.Sprintf("%dd since noncurrent", other.DaysSinceNoncurrentTime))
+	}
+	if !other.NoncurrentTimeBefore.IsZero() {
+		conditions = append(conditions, "noncurrent before "+other.NoncurrentTimeBefore.Format(dateLayout))
+	}
+
+	return strings.Join(conditions, ", ")
+}
+
+func (r LifecycleRule) String() string {
+	return r.ActionString() + " when " + r.ConditionString()
+}
+
+func (b Bucket) GetLifecycleRules() []LifecycleRule {
+	var rules []LifecycleRule
+	for _, rule := range b.attrs.Lifecycle.Rules {
+		rules = append(rules, fromStorageRule(rule))
+	}
+	return rules
+}
+
+// Replace all Lifecycle Rules, failing if the Bucket changed since metageneration
+func UpdateLifecycleRules(bucket string, metageneration int64, rules []LifecycleRule) error {
+	var lifecycle = &storage.Lifecycle{Rules: []storage.LifecycleRule{}}
+	for _, rule := range rules {
+		lifecycle.Rules = append(lifecycle.Rules, rule.toStorageRule())
+	}
+
+	return updateBucket(bucket, metageneration, storage.BucketAttrsToUpdate{Lifecycle: lifecycle})
+}
@@ -0,0 +1,351 @@
+package gcs
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	fieldStyle = lipgloss.NewStyle().Bold(true)
+	valueStyle = lipgloss.NewStyle()
+	linkStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("#3367D6")).Underline(true).Italic(true)
+
+	// Presentation of sizes and times in tables and details
+	format = Format{}
+
+	// Units of SizeSI and SizeIEC, from the smallest
+	siUnits  = []string{"B", "kB", "MB", "GB", "TB", "PB", "EB"}
+	iecUnits = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+
+	// Steps of relative times, from the largest
+	relativeSteps = []struct {
+		unit   time.Duration
+		suffix string
+	}{
+		{365 * 24 * time.Hour, "y"},
+		{30 * 24 * time.Hour, "mo"},
+		{24 * time.Hour, "d"},
+		{time.Hour, "h"},
+		{time.Minute, "m"},
+		{time.Second, "s"},
+	}
+)
+
+// Units sizes are shown in
+const (
+	SizeBytes = "bytes"
+	// Powers of 1000, as in 1.5 GB
+	SizeSI = "si"
+	// Powers of 1024, as in 1.4 GiB
+	SizeIEC = "iec"
+)
+
+// How sizes and times are presented
+type Format struct {
+	// One of SizeBytes, SizeSI or SizeIEC, SizeBytes when empty
+	SizeUnits string `json:"size_units"`
+	// Times relative to now, as in 3h ago
+	Relative bool `json:"relative"`
+	// Times in UTC rather than the local zone
+	UTC bool `json:"utc"`
+	// Go time layout of absolute times, time.DateTime when empty
+	Layout string `json:"layout"`
+}
+
+func SetFormat(f Format) {
+	format = f
+}
+
+func GetFormat() Format {
+	return format
+}
+
+func scaleSize(size int64, base float64, units []string) string {
+	var value = float64(size)
+	var unit = 0
+
+	for value >= base && unit < len(units)-1 {
+		value /= base
+		unit += 1
+	}
+
+	if unit == 0 {
+		return fmt.Sprintf("%d %s", size, units[0])
+	}
+	return fmt.Sprintf("%.1f %s", value, units[unit])
+}
+
+func (f Format) Size(size int64) string {
+	switch f.SizeUnits {
+	case SizeSI:
+		return scaleSize(size, 1000, siUnits)
+	case SizeIEC:
+		return scaleSize(size, 1024, iecUnits)
+	}
+	return fmt.Sprint(size)
+}
+
+func relativeTime(t time.Time) string {
+	var elapsed = time.Since(t)
+	var template = "%d%s ago"
+	if elapsed < 0 {
+		elapsed = -elapsed
+		template = "in %d%s"
+	}
+
+	for _, step := range relativeSteps {
+		if elapsed >= step.unit {
+			return fmt.Sprintf(template, elapsed/step.unit, step.suffix)
+		}
+	}
+
+	return "just now"
+}
+
+func (f Format) Time(t time.Time) string {
+	if t.IsZero() {
+		return "None"
+	}
+	if f.Relative {
+		return relativeTime(t)
+	}
+
+	if f.UTC {
+		t = t.UTC()
+	} else {
+		t = t.Local()
+	}
+
+	if len(f.Layout) == 0 {
+		return t.Format(time.DateTime)
+	}
+	return t.Format(f.Layout)
+}
+
+func renderFieldValue(field, value string) string {
+	return lipgloss.NewStyle().Render(fieldStyle.Render(field) + " " + valueStyle.Render(value) + "\n")
+}
+
+func renderFieldHref(field, href string) string {
+	return lipgloss.NewStyle().Render(fieldStyle.Render(field) + " " + linkStyle.Render(href) + "\n")
+}
+
+func renderIndent(indent int, str string) string {
+	return strings.Repeat("\t", indent) + str
+}
+
+func listToString(list []string, sep string) string {
+	var sb strings.Builder
+
+	for i, item := range list {
+		sb.WriteString(item)
+		if i != len(list)-1 {
+			sb.WriteString(sep)
+		}
+	}
+
+	return sb.String()
+}
+
+func (b Bucket) accessControl() string {
+	if !b.attrs.UniformBucketLevelAccess.Enabled {
+		return "Fine Grained"
+	}
+	return "Uniform"
+}
+
+func (b Bucket) protection() string {
+	if b.attrs.VersioningEnabled {
+		return "Versioning"
+	}
+	return "None"
+}
+
+func (b Bucket) lifecycleRules() string {
+	if len(b.attrs.Lifecycle.Rules) == 0 {
+		return "None"
+	}
+	return fmt.Sprint(len(b.attrs.Lifecycle.Rules), " Rules")
+}
+
+func (b Bucket) labels() string {
+	var labels []string
+	for k, v := range b.attrs.Labels {
+		labels = append(labels, k+": "+v)
+	}
+	sort.Strings(labels)
+	return listToString(labels, ",")
+}
+
+func (b Bucket) requesterPays() string {
+	if b.attrs.RequesterPays {
+		return "ON"
+	}
+	return "OFF"
+}
+
+func (b Bucket) encryption() string {
+	if b.attrs.Encryption != nil {
+		return b.attrs.Encryption.DefaultKMSKeyName
+	}
+	return "Google Managed"
+}
+
+func (b Bucket) website() string {
+	if b.attrs.Website == nil {
+		return "None"
+	}
+	return fmt.Sprintf("main %q, 404 %q", b.attrs.Website.MainPageSuffix, b.attrs.Website.NotFoundPage)
+}
+
+func (b Bucket) logging() string {
+	if b.attrs.Logging == nil {
+		return "None"
+	}
+	return fmt.Sprintf("gs://%s/%s", b.attrs.Logging.LogBucket, b.attrs.Logging.LogObjectPrefix)
+}
+
+func (b Bucket) autoclass() string {
+	if b.attrs.Autoclass == nil || !b.attrs.Autoclass.Enabled {
+		return "Disabled"
+	}
+	return fmt.Sprintf("Enabled, terminal %s (since %s)", b.attrs.Autoclass.TerminalStorageClass,
+		format.Time(b.attrs.Autoclass.ToggleTime))
+}
+
+func (b Bucket) placement() string {
+	if b.attrs.CustomPlacementConfig != nil && len(b.attrs.CustomPlacementConfig.DataLocations) != 0 {
+		return listToString(b.attrs.CustomPlacementConfig.DataLocations, "+")
+	}
+	return b.attrs.Location
+}
+
+func (b Bucket) namespace() string {
+	if b.attrs.HierarchicalNamespace != nil && b.attrs.HierarchicalNamespace.Enabled {
+		return "Enabled"
+	}
+	return "Disabled"
+}
+
+func (b Bucket) replication() string {
+	if b.attrs.RPO == storage.RPOAsyncTurbo {
+		return "Turbo"
+	}
+	return "Default"
+}
+
+// The storage client does not expose when a Bucket was last updated
+func (b Bucket) lastModified() string {
+	return "NA"
+}
+
+// Tags are bound through Resource Manager, they are not among the Bucket Attributes
+func (b Bucket) tags() string {
+	return listToString(nil, ",")
+}
+
+func (b Bucket) DisplayString() string {
+	var sb strings.Builder
+
+	sb.WriteString(renderFieldValue("Last Modified:", b.lastModified()))
+	sb.WriteString(renderFieldValue("Public Access:", b.attrs.PublicAccessPrevention.String()))
+	sb.WriteString(renderFieldValue("Access Control:", b.accessControl()))
+	sb.WriteString(renderFieldValue("Protection:", b.protection()))
+	sb.WriteString(renderFieldValue("Bucket Retention:", toRetentionPolicy(b.attrs.RetentionPolicy).String()))
+	sb.WriteString(renderFieldValue("Life Cycle Rules:", b.lifecycleRules()))
+	sb.WriteString(renderFieldValue("Tags:", b.tags()))
+	sb.WriteString(renderFieldValue("Encryption:", b.encryption()))
+	sb.WriteString(renderFieldValue("Labels:", b.labels()))
+	sb.WriteString(renderFieldValue("Requester Pays:", b.requesterPays()))
+	sb.WriteString(renderFieldValue("Replication:", b.replication()))
+	sb.WriteString(renderFieldValue("Autoclass:", b.autoclass()))
+	sb.WriteString(renderFieldValue("Placement:", b.placement()))
+	sb.WriteString(renderFieldValue("Hierarchical Namespace:", b.namespace()))
+	sb.WriteString(renderFieldValue("Website:", b.website()))
+	sb.WriteString(renderFieldValue("Logging:", b.logging()))
+
+	return sb.String()
+}
+
+func (o Object) publicAccess() string {
+	if len(o.attrs.ACL) == 0 {
+		return "NA"
+	}
+	for _, rule := range o.attrs.ACL {
+		if rule.Entity == storage.AllUsers {
+			return "Public to internet"
+		}
+	}
+	return "Not public"
+}
+
+func (o Object) versionHistory() string {
+	if !o.attrs.Deleted.IsZero() {
+		return fmt.Sprintf("Noncurrent (generation %d)", o.attrs.Generation)
+	}
+	return fmt.Sprintf("Live (generation %d)", o.attrs.Generation)
+}
+
+func (o Object) objectRetainUntil() string {
+	if o.attrs.Retention == nil {
+		return "None"
+	}
+	return format.Time(o.attrs.Retention.RetainUntil) + " (" + o.attrs.Retention.Mode + ")"
+}
+
+func (o Object) holdStatus() string {
+	var holds []string
+	if o.attrs.EventBasedHold {
+		holds = append(holds, "Event Based")
+	}
+	if o.attrs.TemporaryHold {
+		holds = append(holds, "Temporary")
+	}
+	if len(holds) == 0 {
+		return "None"
+	}
+	return listToString(holds, ", ")
+}
+
+func (o Object) encryptionType() string {
+	if len(o.attrs.CustomerKeySHA256) != 0 {
+		return o.attrs.CustomerKeySHA256
+	} else if len(o.attrs.KMSKeyName) != 0 {
+		return o.attrs.KMSKeyName
+	}
+	return "Google Managed"
+}
+
+func (o Object) DisplayString() string {
+	var sb strings.Builder
+
+	var currentIndent = 0
+	sb.WriteString(renderFieldValue("Overview", ""))
+	currentIndent += 1
+	sb.WriteString(renderIndent(currentIndent, renderFieldValue("Custom Time:", format.Time(o.attrs.CustomTime))))
+	sb.WriteString(renderIndent(currentIndent, renderFieldHref("Public URL:", o.GetPublicURL())))
+	sb.WriteString(renderIndent(currentIndent, renderFieldHref("Authenticated URL:", o.GetAuthenticatedURL())))
+	sb.WriteString(renderIndent(currentIndent, renderFieldHref("gsutil URI:", o.GetURI())))
+	currentIndent -= 1
+
+	sb.WriteString(renderFieldValue("Permissions", ""))
+
+	sb.WriteString(renderIndent(currentIndent+1, renderFieldValue("Public Access:", o.publicAccess())))
+
+	sb.WriteString(renderFieldValue("Protection", ""))
+	currentIndent += 1
+	sb.WriteString(renderIndent(currentIndent, renderFieldValue("Version History:", o.versionHistory())))
+	sb.WriteString(renderIndent(currentIndent, renderFieldValue("Retention Expiration Time", "")))
+	sb.WriteString(renderIndent(currentIndent+1, renderFieldValue("Object Retention retain until time:", o.objectRetainUntil())))
+	sb.WriteString(renderIndent(currentIndent+1, renderFieldValue("Bucket Retention retain until time:", format.Time(o.attrs.RetentionExpirationTime))))
+	sb.WriteString(renderIndent(currentIndent, renderFieldValue("Hold Status:", o.holdStatus())))
+	sb.WriteString(renderIndent(currentIndent, renderFieldValue("Encryption Type:", o.encryptionType())))
+	currentIndent -= 1
+
+	return sb.String()
+}
@@ -0,0 +1,81 @@
+package gcs
+
+import (
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/storage"
+)
+
+var (
+	day = 24 * time.Hour
+
+	// Longest Retention Period accepted by Cloud Storage
+	MaxRetentionDays int64 = 3155760000 / (24 * 60 * 60)
+)
+
+// Retention Policy of a Bucket
+type RetentionPolicy struct {
+	Days          int64
+	EffectiveTime time.Time
+	Locked        bool
+}
+
+func (r *RetentionPolicy) String() string {
+	if r == nil {
+		return "None"
+	}
+
+	var state = "unlocked"
+	if r.Locked {
+		state = "locked"
+	}
+
+	return fmt.Sprintf("%d days, %s (effective %s)", r.Days, state, format.Time(r.EffectiveTime))
+}
+
+func toRetentionPolicy(policy *storage.RetentionPolicy) *RetentionPolicy {
+	if policy == nil || policy.RetentionPeriod == 0 {
+		return nil
+	}
+
+	return &RetentionPolicy{
+		Days:          int64(policy.RetentionPeriod / day),
+		EffectiveTime: policy.EffectiveTime,
+		Locked:        policy.IsLocked,
+	}
+}
+
+func (b Bucket) GetRetentionPolicy() *RetentionPolicy {
+	return toRetentionPolicy(b.attrs.RetentionPolicy)
+}
+
+func ValidateRetentionDays(days int64, current *RetentionPolicy) error {
+	if days < 0 || days > MaxRetentionDays {
+		return DataError{fmt.Sprintf("retention period must be between 0 and %d days", MaxRetentionDays)}
+	}
+	if current != nil && current.Locked && days < current.Days {
+		return DataError{fmt.Sprintf("a locked retention period can only be increased (currently %d days)", current.Days)}
+	}
+	return nil
+}
+
+// Set the Retention Period in days, 0 removes the Policy; returns the new metageneration
+func UpdateRetentionPeriod(bucket string, metageneration int64, days int64) (int64, error) {
+	attrs, err := client.Bucket(bucket).
+		If(storage.BucketConditions{MetagenerationMatch: metageneration}).
+		Update(ctx, storage.BucketAttrsToUpdate{
+			RetentionPolicy: &storage.RetentionPolicy{RetentionPeriod: time.Duration(days) * day},
+		})
+	if err != nil {
+		return 0, err
+	}
+	return attrs.MetaGeneration, nil
+}
+
+// Permanently lock the Retention Policy, this cannot be undone
+func LockRetentionPolicy(bucket string, metageneration int64) error {
+	return client.Bucket(bucket).
+		If(storage.BucketConditions{MetagenerationMatch: metageneration}).
+		LockRetentionPolicy(ctx)
+}
@@ -0,0 +1,137 @@
+package gcs
+
+import (
+	"fmt"
+	"regexp"
+
+	"cloud.google.com/go/storage"
+)
+
+var (
+	StorageClasses = []string{"STANDARD", "NEARLINE", "COLDLINE", "ARCHIVE"}
+
+	PublicAccessPreventions = []string{
+		storage.PublicAccessPreventionInherited.String(),
+		storage.PublicAccessPreventionEnforced.String(),
+	}
+
+	labelKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,62}$`)
+)
+
+// Bucket Settings editable from the Dialog
+type BucketSettings struct {
+	Versioning             bool
+	UniformAccess          bool
+	PublicAccessPrevention string
+	StorageClass           string
+	Labels                 map[string]string
+}
+
+func (b Bucket) GetSettings() BucketSettings {
+	var labels = make(map[string]string)
+	for k, v := range b.attrs.Labels {
+		labels[k] = v
+	}
+
+	return BucketSettings{
+		Versioning:             b.attrs.VersioningEnabled,
+		UniformAccess:          b.attrs.UniformBucketLevelAccess.Enabled,
+		PublicAccessPrevention: b.attrs.PublicAccessPrevention.String(),
+		StorageClass:           b.attrs.StorageClass,
+		Labels:                 labels,
+	}
+}
+
+func (b Bucket) GetMetageneration() int64 {
+	return b.attrs.MetaGeneration
+}
+
+func ValidateLabel(key, value string) error {
+	if !labelKeyPattern.MatchString(key) {
+		return DataError{fmt.Sprintf("invalid label key %q", key)}
+	}
+	if len(value) > 63 {
+		return DataError{fmt.Sprintf("label value for %q is longer than 63 characters", key)}
+	}
+	return nil
+}
+
+func toPublicAccessPrevention(value string) storage.PublicAccessPrevention {
+	if value == storage.PublicAccessPreventionEnforced.String() {
+		return storage.PublicAccessPreventionEnforced
+	}
+	return storage.PublicAccessPreventionInherited
+}
+
+// Describe the changes between two Settings, one line per change
+func DiffSettings(old, new BucketSettings) []string {
+	var changes []string
+
+	if old.Versioning != new.Versioning {
+		changes = append(changes, fmt.Sprintf("Versioning: %v -> %v", old.Versioning, new.Versioning))
+	}
+	if old.UniformAccess != new.UniformAccess {
+		changes = append(changes, fmt.Sprintf("Uniform Access: %v -> %v", old.UniformAccess, new.UniformAccess))
+	}
+	if old.PublicAccessPrevention != new.PublicAccessPrevention {
+		changes = append(changes, fmt.Sprintf("Public Access Prevention: %s -> %s", old.PublicAccessPrevention, new.PublicAccessPrevention))
+	}
+	if old.StorageClass != new.StorageClass {
+		changes = append(changes, fmt.Sprintf("Default Storage Class: %s -> %s", old.StorageClass, new.StorageClass))
+	}
+	for k, v := range new.Labels {
+		if oldValue, ok := old.Labels[k]; !ok {
+			changes = append(changes, fmt.Sprintf("+ Label %s: %s", k, v))
+		} else if oldValue != v {
+			changes = append(changes, fmt.Sprintf("~ Label %s: %s -> %s", k, oldValue, v))
+		}
+	}
+	for k, v := range old.Labels {
+		if _, ok := new.Labels[k]; !ok {
+			changes = append(changes, fmt.Sprintf("- Label %s: %s", k, v))
+		}
+	}
+
+	return changes
+}
+
+// Apply the changed Settings, failing if the Bucket changed since metageneration
+func UpdateBucketSettings(bucket string, metageneration int64, old, new BucketSettings) error {
+	var update storage.BucketAttrsToUpdate
+
+	if old.Versioning != new.Versioning {
+		update.VersioningEnabled = new.Versioning
+	}
+	if old.UniformAccess != new.UniformAccess {
+		update.UniformBucketLevelAccess = &storage.UniformBucketLevelAccess{Enabled: new.UniformAccess}
+	}
+	if old.PublicAccessPrevention != new.PublicAccessPrevention {
+		update.PublicAccessPrevention = toPublicAccessPrevention(new.PublicAccessPrevention)
+	}
+	if old.StorageClass != new.StorageClass {
+		update.StorageClass = new.StorageClass
+	}
+	for k, v := range new.Labels {
+		if oldValue, ok := old.Labels[k]; !ok || oldValue != v {
+			update.SetLabel(k, v)
+		}
+	}
+	for k := range old.Labels {
+		if _, ok := new.Labels[k]; !ok {
+			update.DeleteLabel(k)
+		}
+	}
+
+	return updateBucket(bucket, metageneration, update)
+}
+
+func updateBucket(bucket string, metageneration int64, update storage.BucketAttrsToUpdate) error {
+	_, err := client.Bucket(bucket).
+		If(storage.BucketConditions{MetagenerationMatch: metageneration}).
+		Update(ctx, update)
+	return err
+}
+
+func (b Bucket) IsUniformAccess() bool {
+	return b.attrs.UniformBucketLevelAccess.Enabled
+}
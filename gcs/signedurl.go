@@ -0,0 +1,84 @@
+package gcs
+
+import (
+	"encoding/base64"
+	"os"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/iamcredentials/v1"
+)
+
+var (
+	SignedURLMethods = []string{"GET", "PUT"}
+
+	// Longest Expiry of a V4 Signed URL
+	MaxSignedURLExpiry = 7 * 24 * time.Hour
+)
+
+// Options of a V4 Signed URL
+type SignOptions struct {
+	Method      string
+	Expiry      time.Duration
+	ContentType string
+	// Path of a Service Account JSON Key, or a Service Account Email signing through IAM signBlob,
+	// empty to use the default Credentials
+	Signer string
+}
+
+func (o SignOptions) Validate() error {
+	if o.Expiry <= 0 || o.Expiry > MaxSignedURLExpiry {
+		return DataError{"expiry must be between 1s and 7 days (168h)"}
+	}
+	return nil
+}
+
+// Sign using IAM signBlob on behalf of the Service Account
+func signBlob(serviceAccount string) func([]byte) ([]byte, error) {
+	return func(payload []byte) ([]byte, error) {
+		service, err := iamcredentials.NewService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := service.Projects.ServiceAccounts.SignBlob(
+			"projects/-/serviceAccounts/"+serviceAccount,
+			&iamcredentials.SignBlobRequest{Payload: base64.StdEncoding.EncodeToString(payload)},
+		).Context(ctx).Do()
+		if err != nil {
+			return nil, err
+		}
+
+		return base64.StdEncoding.DecodeString(response.SignedBlob)
+	}
+}
+
+func SignedURL(bucket, object string, options SignOptions) (string, error) {
+	var opts = &storage.SignedURLOptions{
+		Scheme:      storage.SigningSchemeV4,
+		Method:      options.Method,
+		Expires:     time.Now().Add(options.Expiry),
+		ContentType: options.ContentType,
+	}
+
+	switch {
+	case strings.HasSuffix(options.Signer, ".json"):
+		key, err := os.ReadFile(options.Signer)
+		if err != nil {
+			return "", err
+		}
+		config, err := google.JWTConfigFromJSON(key)
+		if err != nil {
+			return "", err
+		}
+		opts.GoogleAccessID = config.Email
+		opts.PrivateKey = config.PrivateKey
+	case len(options.Signer) != 0:
+		opts.GoogleAccessID = options.Signer
+		opts.SignBytes = signBlob(options.Signer)
+	}
+
+	return client.Bucket(bucket).SignedURL(object, opts)
+}
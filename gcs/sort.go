@@ -0,0 +1,120 @@
+package gcs
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// Column of a Listing, by its title, and the direction it is sorted in
+type SortOrder struct {
+	Column     string
+	Descending bool
+}
+
+var (
+	// Comparisons of the sortable Object Columns
+	objectSorters = map[string]func(a, b *Object) int{
+		"Name":   func(a, b *Object) int { return strings.Compare(a.GetName(), b.GetName()) },
+		"Bucket": func(a, b *Object) int { return strings.Compare(a.GetBucketName(), b.GetBucketName()) },
+		"Size":   func(a, b *Object) int { return cmp.Compare(a.GetSize(), b.GetSize()) },
+		"Type":   func(a, b *Object) int { return strings.Compare(a.GetContentType(), b.GetContentType()) },
+		"Storage Class": func(a, b *Object) int {
+			return strings.Compare(a.GetStorageClass(), b.GetStorageClass())
+		},
+		"Created":       func(a, b *Object) int { return a.GetCreated().Compare(b.GetCreated()) },
+		"Last Modified": func(a, b *Object) int { return a.GetUpdated().Compare(b.GetUpdated()) },
+		"Custom Time":   func(a, b *Object) int { return a.attrs.CustomTime.Compare(b.attrs.CustomTime) },
+		"Generation":    func(a, b *Object) int { return cmp.Compare(a.attrs.Generation, b.attrs.Generation) },
+		"Metageneration": func(a, b *Object) int {
+			return cmp.Compare(a.attrs.Metageneration, b.attrs.Metageneration)
+		},
+	}
+
+	// Comparisons of the sortable Bucket Columns
+	bucketSorters = map[string]func(a, b *Bucket) int{
+		"Name":         func(a, b *Bucket) int { return strings.Compare(a.GetName(), b.GetName()) },
+		"Created":      func(a, b *Bucket) int { return a.GetCreated().Compare(b.GetCreated()) },
+		"LocationType": func(a, b *Bucket) int { return strings.Compare(a.attrs.LocationType, b.attrs.LocationType) },
+		"Location":     func(a, b *Bucket) int { return strings.Compare(a.attrs.Location, b.attrs.Location) },
+		"DefaultStorageClass": func(a, b *Bucket) int {
+			return strings.Compare(a.attrs.StorageClass, b.attrs.StorageClass)
+		},
+		"Autoclass":   func(a, b *Bucket) int { return strings.Compare(a.autoclassShort(), b.autoclassShort()) },
+		"HNS":         func(a, b *Bucket) int { return strings.Compare(a.namespace(), b.namespace()) },
+		"Placement":   func(a, b *Bucket) int { return strings.Compare(a.placement(), b.placement()) },
+		"Replication": func(a, b *Bucket) int { return strings.Compare(a.replication(), b.replication()) },
+		"Metageneration": func(a, b *Bucket) int {
+			return cmp.Compare(a.attrs.MetaGeneration, b.attrs.MetaGeneration)
+		},
+	}
+)
+
+// Titles of the sortable Columns, in table order
+func (data Data) SortColumns(details bool) []string {
+	var cols, _ = data.GetTableData(details)
+	var columns []string
+
+	for _, col := range cols {
+		columns = append(columns, col.Title)
+	}
+
+	return columns
+}
+
+// Comparison of a Column by its typed value, else by its presented value
+func comparison[T any](sorters map[string]func(a, b T) int, columns []column[T], title string) (func(a, b T) int, bool) {
+	if compare, ok := sorters[title]; ok {
+		return compare, true
+	}
+
+	for _, col := range columns {
+		if col.title == title {
+			return func(a, b T) int { return strings.Compare(col.value(a), col.value(b)) }, true
+		}
+	}
+
+	return nil, false
+}
+
+func sortBy[T any](items []T, compare func(a, b T) int, descending bool, selected int) int {
+	var indexes = make([]int, len(items))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	// Stable, so equal values keep the listed order
+	slices.SortStableFunc(indexes, func(a, b int) int {
+		if descending {
+			return compare(items[b], items[a])
+		}
+		return compare(items[a], items[b])
+	})
+
+	var sorted = make([]T, len(items))
+	var position = -1
+	for i, index := range indexes {
+		sorted[i] = items[index]
+		if index == selected {
+			position = i
+		}
+	}
+	copy(items, sorted)
+
+	return position
+}
+
+// Sort the Listing, returning the new index of the selected item.
+// Columns the Listing does not have leave it unchanged.
+func (data *Data) Sort(order SortOrder, selected int) int {
+	if data.IsBucket {
+		if compare, ok := comparison(bucketSorters, data.bucketColumns(true), order.Column); ok {
+			return sortBy(data.buckets, compare, order.Descending, selected)
+		}
+	} else {
+		if compare, ok := comparison(objectSorters, data.objectColumns(), order.Column); ok {
+			return sortBy(data.objects, compare, order.Descending, selected)
+		}
+	}
+	return selected
+}
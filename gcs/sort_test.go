@@ -0,0 +1,41 @@
+package gcs
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func TestSortBy(t *testing.T) {
+	type item struct {
+		name string
+		size int
+	}
+	var bySize = func(a, b item) int { return cmp.Compare(a.size, b.size) }
+
+	var tests = []struct {
+		name       string
+		descending bool
+		selected   int
+		want       []string
+		position   int
+	}{
+		{"ascending keeps equal items in order", false, 0, []string{"b", "a", "d", "c"}, 1},
+		{"descending keeps equal items in order", true, 2, []string{"c", "a", "d", "b"}, 0},
+		{"no selection", false, -1, []string{"b", "a", "d", "c"}, -1},
+	}
+
+	for _, test := range tests {
+		var items = []item{{"a", 2}, {"b", 1}, {"c", 3}, {"d", 2}}
+
+		var position = sortBy(items, bySize, test.descending, test.selected)
+
+		var names []string
+		for _, item := range items {
+			names = append(names, item.name)
+		}
+		if !slices.Equal(names, test.want) || position != test.position {
+			t.Errorf("%s: got %v at %d, want %v at %d", test.name, names, position, test.want, test.position)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package gcs
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"cloud.google.com/go/storage"
+)
+
+var (
+	NotificationEvents = []string{storage.ObjectFinalizeEvent, storage.ObjectMetadataUpdateEvent,
+		storage.ObjectDeleteEvent, storage.ObjectArchiveEvent}
+
+	PayloadFormats = []string{storage.JSONPayload, storage.NoPayload}
+
+	topicPattern = regexp.MustCompile(`^projects/([^/]+)/topics/([^/]+)$`)
+)
+
+// Static Website Configuration of a Bucket
+type Website struct {
+	MainPage     string
+	NotFoundPage string
+}
+
+func (b Bucket) GetWebsite() Website {
+	if b.attrs.Website == nil {
+		return Website{}
+	}
+	return Website{MainPage: b.attrs.Website.MainPageSuffix, NotFoundPage: b.attrs.Website.NotFoundPage}
+}
+
+func UpdateWebsite(bucket string, metageneration int64, website Website) error {
+	return updateBucket(bucket, metageneration, storage.BucketAttrsToUpdate{
+		Website: &storage.BucketWebsite{MainPageSuffix: website.MainPage, NotFoundPage: website.NotFoundPage},
+	})
+}
+
+// Access Logging Configuration of a Bucket
+type Logging struct {
+	LogBucket string
+	Prefix    string
+}
+
+func (b Bucket) GetLogging() Logging {
+	if b.attrs.Logging == nil {
+		return Logging{}
+	}
+	return Logging{LogBucket: b.attrs.Logging.LogBucket, Prefix: b.attrs.Logging.LogObjectPrefix}
+}
+
+func UpdateLogging(bucket string, metageneration int64, logging Logging) error {
+	return updateBucket(bucket, metageneration, storage.BucketAttrsToUpdate{
+		Logging: &storage.BucketLogging{LogBucket: logging.LogBucket, LogObjectPrefix: logging.Prefix},
+	})
+}
+
+// Pub/Sub Notification Configuration of a Bucket, ID is empty until created
+type Notification struct {
+	ID         string
+	Topic      string
+	EventTypes []string
+	Prefix     string
+	Payload    string
+}
+
+func (n Notification) String() string {
+	var events = "all events"
+	if len(n.EventTypes) != 0 {
+		events = strings.Join(n.EventTypes, ",")
+	}
+	return fmt.Sprintf("%s %s prefix=%q %s", n.Topic, events, n.Prefix, n.Payload)
+}
+
+// Project and ID of the Topic, fully qualified once validated
+func (n Notification) topic() (string, string) {
+	if match := topicPattern.FindStringSubmatch(n.Topic); match != nil {
+		return match[1], match[2]
+	}
+	return "", n.Topic
+}
+
+func (n Notification) Validate() error {
+	if !topicPattern.MatchString(n.Topic) {
+		return DataError{"topic must be projects/<project>/topics/<topic>"}
+	}
+	for _, event := range n.EventTypes {
+		var valid = false
+		for _, known := range NotificationEvents {
+			if event == known {
+				valid = true
+			}
+		}
+		if !valid {
+			return DataError{fmt.Sprintf("unknown event type %q", event)}
+		}
+	}
+	return nil
+}
+
+func GetNotifications(bucket string) ([]Notification, error) {
+	configs, err := client.Bucket(bucket).Notifications(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var notifications []Notification
+	for _, config := range configs {
+		notifications = append(notifications, Notification{
+			ID:         config.ID,
+			Topic:      fmt.Sprintf("projects/%s/topics/%s", config.TopicProjectID, config.TopicID),
+			EventTypes: config.EventTypes,
+			Prefix:     config.ObjectNamePrefix,
+			Payload:    config.PayloadFormat,
+		})
+	}
+
+	sort.Slice(notifications, func(i, j int) bool {
+		return notifications[i].ID < notifications[j].ID
+	})
+
+	return notifications, nil
+}
+
+// Delete the Notifications no longer present and create the new ones
+func UpdateNotifications(bucket string, old, new []Notification) error {
+	var handle = client.Bucket(bucket)
+	var kept = make(map[string]bool)
+
+	for _, notification := range new {
+		kept[notification.ID] = len(notification.ID) != 0
+	}
+
+	for _, notification := range old {
+		if !kept[notification.ID] {
+			if err := handle.DeleteNotification(ctx, notification.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, notification := range new {
+		if len(notification.ID) != 0 {
+			continue
+		}
+		project, topic := notification.topic()
+		_, err := handle.AddNotification(ctx, &storage.Notification{
+			TopicProjectID:   project,
+			TopicID:          topic,
+			EventTypes:       notification.EventTypes,
+			ObjectNamePrefix: notification.Prefix,
+			PayloadFormat:    notification.Payload,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -3,8 +3,12 @@ module github.com/charan-kumar-137/gsui
 go 1.22.5
 
 replace (
+	github.com/charan-kumar-137/gsui/bookmarks => ./bookmarks
+	github.com/charan-kumar-137/gsui/clipboard => ./clipboard
+	github.com/charan-kumar-137/gsui/config => ./config
 	github.com/charan-kumar-137/gsui/dialog => ./dialog
 	github.com/charan-kumar-137/gsui/display => ./display
+	github.com/charan-kumar-137/gsui/form => ./form
 	github.com/charan-kumar-137/gsui/gcs => ./gcs
 	github.com/charan-kumar-137/gsui/keys => ./keys
 	github.com/charan-kumar-137/gsui/list => ./list
@@ -12,11 +16,19 @@ replace (
 )
 
 require (
+	cloud.google.com/go/iam v1.1.8
 	cloud.google.com/go/storage v1.43.0
+	github.com/atotto/clipboard v0.1.4
+	github.com/aymanbagabas/go-osc52/v2 v2.0.1
 	github.com/charmbracelet/bubbles v0.18.0
 	github.com/charmbracelet/bubbletea v0.26.6
 	github.com/charmbracelet/lipgloss v0.12.1
+	github.com/mattn/go-runewidth v0.0.15
+	github.com/mdp/qrterminal/v3 v3.2.0
+	golang.org/x/oauth2 v0.21.0
 	golang.org/x/term v0.22.0
+	google.golang.org/api v0.187.0
+	google.golang.org/genproto v0.0.0-20240624140628-dc46fd24d27d
 )
 
 require (
@@ -24,9 +36,6 @@ require (
 	cloud.google.com/go/auth v0.6.1 // indirect
 	cloud.google.com/go/auth/oauth2adapt v0.2.2 // indirect
 	cloud.google.com/go/compute/metadata v0.3.0 // indirect
-	cloud.google.com/go/iam v1.1.8 // indirect
-	github.com/atotto/clipboard v0.1.4 // indirect
-	github.com/aymanbagabas/go-osc52/v2 v2.0.1 // indirect
 	github.com/charmbracelet/x/ansi v0.1.4 // indirect
 	github.com/charmbracelet/x/input v0.1.0 // indirect
 	github.com/charmbracelet/x/term v0.1.1 // indirect
@@ -44,7 +53,6 @@ require (
 	github.com/lucasb-eyer/go-colorful v1.2.0 // indirect
 	github.com/mattn/go-isatty v0.0.20 // indirect
 	github.com/mattn/go-localereader v0.0.1 // indirect
-	github.com/mattn/go-runewidth v0.0.15 // indirect
 	github.com/muesli/ansi v0.0.0-20230316100256-276c6243b2f6 // indirect
 	github.com/muesli/cancelreader v0.2.2 // indirect
 	github.com/muesli/termenv v0.15.2 // indirect
@@ -58,15 +66,13 @@ require (
 	go.opentelemetry.io/otel/trace v1.24.0 // indirect
 	golang.org/x/crypto v0.24.0 // indirect
 	golang.org/x/net v0.26.0 // indirect
-	golang.org/x/oauth2 v0.21.0 // indirect
 	golang.org/x/sync v0.7.0 // indirect
 	golang.org/x/sys v0.22.0 // indirect
 	golang.org/x/text v0.16.0 // indirect
 	golang.org/x/time v0.5.0 // indirect
-	google.golang.org/api v0.187.0 // indirect
-	google.golang.org/genproto v0.0.0-20240624140628-dc46fd24d27d // indirect
 	google.golang.org/genproto/googleapis/api v0.0.0-20240617180043-68d350f18fd4 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20240624140628-dc46fd24d27d // indirect
 	google.golang.org/grpc v1.64.0 // indirect
 	google.golang.org/protobuf v1.34.2 // indirect
+	rsc.io/qr v0.2.0 // indirect
 )
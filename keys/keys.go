@@ -13,6 +13,61 @@ type KeyMap struct {
 	Escape key.Binding
 	Tab    key.Binding
 
+	// Form Navigation
+	Next   key.Binding
+	Prev   key.Binding
+	Submit key.Binding
+	Save   key.Binding
+
+	// Editor
+	Add    key.Binding
+	Edit   key.Binding
+	Remove key.Binding
+	Apply  key.Binding
+
+	// Actions
+	Delete        key.Binding
+	EditBucket    key.Binding
+	Lifecycle     key.Binding
+	IAM           key.Binding
+	CORS          key.Binding
+	Retention     key.Binding
+	Website       key.Binding
+	Logging       key.Binding
+	Notifications key.Binding
+	Autoclass     key.Binding
+	Details       key.Binding
+	SignURL       key.Binding
+	Settings      key.Binding
+	Download      key.Binding
+	StorageClass  key.Binding
+
+	// Listing
+	Filter    key.Binding
+	Complete  key.Binding
+	Back      key.Binding
+	Forward   key.Binding
+	Sort      key.Binding
+	SortOrder key.Binding
+
+	// Marking
+	Mark        key.Binding
+	MarkRange   key.Binding
+	MarkAll     key.Binding
+	InvertMarks key.Binding
+	MarkPattern key.Binding
+
+	// Bookmarks
+	Bookmark       key.Binding
+	Bookmarks      key.Binding
+	RemoveBookmark key.Binding
+
+	// Clipboard
+	CopyURI              key.Binding
+	CopyAuthenticatedURL key.Binding
+	CopyPublicURL        key.Binding
+	RawJSON              key.Binding
+
 	Quit key.Binding
 }
 
@@ -23,6 +78,16 @@ func (k KeyMap) ShortHelp() []key.Binding {
 func (k KeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{k.Up, k.Down, k.Left, k.Right},
+		{k.Next, k.Prev, k.Submit, k.Save},
+		{k.Add, k.Edit, k.Remove, k.Apply, k.RawJSON},
+		{k.Delete, k.EditBucket, k.Lifecycle, k.IAM, k.CORS, k.Retention},
+		{k.Website, k.Logging, k.Notifications, k.Autoclass},
+		{k.Details, k.SignURL, k.Settings},
+		{k.Download, k.StorageClass},
+		{k.Filter, k.Complete, k.Back, k.Forward, k.Sort, k.SortOrder},
+		{k.Mark, k.MarkRange, k.MarkAll, k.InvertMarks, k.MarkPattern},
+		{k.Bookmark, k.Bookmarks, k.RemoveBookmark},
+		{k.CopyURI, k.CopyAuthenticatedURL, k.CopyPublicURL},
 		{k.Quit},
 	}
 }
@@ -62,6 +127,177 @@ var Keys = KeyMap{
 		key.WithHelp("tab", "Tab"),
 	),
 
+	Next: key.NewBinding(
+		key.WithKeys("tab", "down"),
+		key.WithHelp("tab/↓", "next field"),
+	),
+	Prev: key.NewBinding(
+		key.WithKeys("shift+tab", "up"),
+		key.WithHelp("shift+tab/↑", "previous field"),
+	),
+	Submit: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "submit"),
+	),
+	Save: key.NewBinding(
+		key.WithKeys("ctrl+s"),
+		key.WithHelp("ctrl+s", "submit form"),
+	),
+
+	Add: key.NewBinding(
+		key.WithKeys("a"),
+		key.WithHelp("a", "add"),
+	),
+	Edit: key.NewBinding(
+		key.WithKeys("e", "enter"),
+		key.WithHelp("e/enter", "edit"),
+	),
+	Remove: key.NewBinding(
+		key.WithKeys("d", "delete"),
+		key.WithHelp("d/delete", "remove"),
+	),
+	Apply: key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "apply changes"),
+	),
+	RawJSON: key.NewBinding(
+		key.WithKeys("j"),
+		key.WithHelp("j", "edit as json"),
+	),
+
+	Delete: key.NewBinding(
+		key.WithKeys("D"),
+		key.WithHelp("D", "delete bucket / object(s)"),
+	),
+	EditBucket: key.NewBinding(
+		key.WithKeys("e"),
+		key.WithHelp("e", "edit bucket settings"),
+	),
+	Lifecycle: key.NewBinding(
+		key.WithKeys("L"),
+		key.WithHelp("L", "lifecycle rules"),
+	),
+	IAM: key.NewBinding(
+		key.WithKeys("I"),
+		key.WithHelp("I", "iam policy / object acl"),
+	),
+	CORS: key.NewBinding(
+		key.WithKeys("C"),
+		key.WithHelp("C", "cors configuration"),
+	),
+	Retention: key.NewBinding(
+		key.WithKeys("R"),
+		key.WithHelp("R", "retention policy"),
+	),
+	Website: key.NewBinding(
+		key.WithKeys("W"),
+		key.WithHelp("W", "static website"),
+	),
+	Logging: key.NewBinding(
+		key.WithKeys("G"),
+		key.WithHelp("G", "access logging"),
+	),
+	Notifications: key.NewBinding(
+		key.WithKeys("N"),
+		key.WithHelp("N", "pub/sub notifications"),
+	),
+	Autoclass: key.NewBinding(
+		key.WithKeys("A"),
+		key.WithHelp("A", "autoclass"),
+	),
+	Details: key.NewBinding(
+		key.WithKeys("x"),
+		key.WithHelp("x", "toggle detail columns"),
+	),
+	SignURL: key.NewBinding(
+		key.WithKeys("U"),
+		key.WithHelp("U", "signed url"),
+	),
+	Settings: key.NewBinding(
+		key.WithKeys("S"),
+		key.WithHelp("S", "display settings"),
+	),
+	Download: key.NewBinding(
+		key.WithKeys("d"),
+		key.WithHelp("d", "download object(s)"),
+	),
+	StorageClass: key.NewBinding(
+		key.WithKeys("T"),
+		key.WithHelp("T", "change storage class"),
+	),
+
+	Filter: key.NewBinding(
+		key.WithKeys("/"),
+		key.WithHelp("/", "filter listing"),
+	),
+	Complete: key.NewBinding(
+		key.WithKeys("ctrl+@", "ctrl+f"),
+		key.WithHelp("ctrl+space/ctrl+f", "complete path"),
+	),
+	Back: key.NewBinding(
+		key.WithKeys("alt+left", "["),
+		key.WithHelp("alt+←/[", "back"),
+	),
+	Forward: key.NewBinding(
+		key.WithKeys("alt+right", "]"),
+		key.WithHelp("alt+→/]", "forward"),
+	),
+	Sort: key.NewBinding(
+		key.WithKeys("o"),
+		key.WithHelp("o", "sort by next column"),
+	),
+	SortOrder: key.NewBinding(
+		key.WithKeys("O"),
+		key.WithHelp("O", "reverse sort order"),
+	),
+
+	Mark: key.NewBinding(
+		key.WithKeys(" "),
+		key.WithHelp("space", "toggle mark"),
+	),
+	MarkRange: key.NewBinding(
+		key.WithKeys("v"),
+		key.WithHelp("v", "mark range to last toggled"),
+	),
+	MarkAll: key.NewBinding(
+		key.WithKeys("ctrl+a"),
+		key.WithHelp("ctrl+a", "mark all / none"),
+	),
+	InvertMarks: key.NewBinding(
+		key.WithKeys("!"),
+		key.WithHelp("!", "invert marks"),
+	),
+	MarkPattern: key.NewBinding(
+		key.WithKeys("*"),
+		key.WithHelp("*", "mark by pattern"),
+	),
+
+	Bookmark: key.NewBinding(
+		key.WithKeys("b"),
+		key.WithHelp("b", "bookmark path"),
+	),
+	Bookmarks: key.NewBinding(
+		key.WithKeys("B"),
+		key.WithHelp("B", "open bookmarks"),
+	),
+	RemoveBookmark: key.NewBinding(
+		key.WithKeys("ctrl+d"),
+		key.WithHelp("ctrl+d", "remove bookmark"),
+	),
+
+	CopyURI: key.NewBinding(
+		key.WithKeys("c"),
+		key.WithHelp("c", "copy gs:// uri"),
+	),
+	CopyAuthenticatedURL: key.NewBinding(
+		key.WithKeys("u"),
+		key.WithHelp("u", "copy authenticated url"),
+	),
+	CopyPublicURL: key.NewBinding(
+		key.WithKeys("p"),
+		key.WithHelp("p", "copy public url"),
+	),
+
 	Quit: key.NewBinding(
 		key.WithKeys("q", "ctrl+c"),
 		key.WithHelp("q", "quit"),
@@ -0,0 +1,60 @@
+package list
+
+import (
+	"github.com/charmbracelet/bubbles/table"
+)
+
+var (
+	// Narrowest the Name Column and the others are shrunk to
+	minNameWidth   = 20
+	minColumnWidth = 6
+
+	// Padding the table adds around each cell
+	cellPadding = 2
+)
+
+// Fit the Column Widths to the table width.
+// Name takes the room left over, or gives up room first, then the widest Columns shrink.
+// The table truncates what no longer fits with an ellipsis.
+func fitColumns(cols []table.Column, width int) []table.Column {
+	if width <= 0 || len(cols) == 0 {
+		return cols
+	}
+
+	var fitted = append([]table.Column{}, cols...)
+	var total = 0
+	for _, col := range fitted {
+		total += col.Width + cellPadding
+	}
+
+	if total <= width {
+		fitted[0].Width += width - total
+		return fitted
+	}
+
+	var excess = total - width
+	var shrink = min(excess, max(fitted[0].Width-minNameWidth, 0))
+	fitted[0].Width -= shrink
+	excess -= shrink
+
+	for excess > 0 {
+		var widest = -1
+		for i := 1; i < len(fitted); i++ {
+			if fitted[i].Width > minColumnWidth && (widest == -1 || fitted[i].Width > fitted[widest].Width) {
+				widest = i
+			}
+		}
+		if widest == -1 {
+			break
+		}
+		fitted[widest].Width -= 1
+		excess -= 1
+	}
+
+	return fitted
+}
+
+// Columns of the Listing, marked with the Sort Order and fitted to the width
+func (m Model) tableColumns() []table.Column {
+	return fitColumns(sortColumns(m.data.GetTableColumns(m.details), m.sort), m.width)
+}
@@ -0,0 +1,52 @@
+package list
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/table"
+)
+
+func widths(cols []table.Column) []int {
+	var widths []int
+	for _, col := range cols {
+		widths = append(widths, col.Width)
+	}
+	return widths
+}
+
+func TestFitColumns(t *testing.T) {
+	var cols = []table.Column{
+		{Title: "Name", Width: 30},
+		{Title: "Size", Width: 10},
+		{Title: "Type", Width: 20},
+	}
+
+	var tests = []struct {
+		name  string
+		cols  []table.Column
+		width int
+		want  []int
+	}{
+		{"no columns", nil, 80, nil},
+		{"no width yet", cols, 0, []int{30, 10, 20}},
+		{"exact fit", cols, 66, []int{30, 10, 20}},
+		{"name takes the room left", cols, 100, []int{64, 10, 20}},
+		{"name shrinks first", cols, 60, []int{24, 10, 20}},
+		{"then the widest column", cols, 50, []int{20, 10, 14}},
+		{"widest columns shrink evenly", cols, 40, []int{20, 7, 7}},
+		{"narrower than the minimums", cols, 10, []int{20, 6, 6}},
+		{"single column", cols[:1], 10, []int{20}},
+	}
+
+	for _, test := range tests {
+		if got := widths(fitColumns(test.cols, test.width)); !slices.Equal(got, test.want) {
+			t.Errorf("%s: widths %v, want %v", test.name, got, test.want)
+		}
+	}
+
+	// The Columns passed in are left untouched
+	if cols[0].Width != 30 {
+		t.Errorf("fitColumns changed its input: %v", widths(cols))
+	}
+}
@@ -0,0 +1,161 @@
+package list
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+)
+
+var (
+	matchStyle  = lipgloss.NewStyle().Bold(true).Underline(true)
+	filterStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#3367D6"))
+)
+
+func newFilterInput() textinput.Model {
+	ti := textinput.New()
+	ti.Prompt = "/"
+	ti.Placeholder = "filter"
+	return ti
+}
+
+// Positions (in runes) of pattern matched as a case insensitive subsequence of name
+func fuzzyMatch(pattern, name string) ([]int, bool) {
+	var positions []int
+	var runes = []rune(pattern)
+	var next = 0
+
+	for i, r := range []rune(name) {
+		if next == len(runes) {
+			break
+		}
+		if unicode.ToLower(r) == unicode.ToLower(runes[next]) {
+			positions = append(positions, i)
+			next += 1
+		}
+	}
+
+	return positions, next == len(runes)
+}
+
+// Highlight the matched characters, unless the highlighting would not fit the column
+func highlight(name string, positions []int, width int) string {
+	var matched = make(map[int]bool)
+	for _, position := range positions {
+		matched[position] = true
+	}
+
+	var sb strings.Builder
+	for i, r := range []rune(name) {
+		if matched[i] {
+			sb.WriteString(matchStyle.Render(string(r)))
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+
+	// Table truncates by width counting the escape sequences
+	if runewidth.StringWidth(sb.String()) > width {
+		return name
+	}
+
+	return sb.String()
+}
+
+func (m Model) IsFiltering() bool {
+	return m.filtering
+}
+
+// Index into the listed Data of a table row
+func (m Model) dataIndex(row int) int {
+	if m.matches == nil {
+		return row
+	}
+	if row < 0 || row >= len(m.matches) {
+		return -1
+	}
+	return m.matches[row]
+}
+
+// Narrow the table rows to the names matching the filter
+func (m *Model) applyFilter() {
+	m.filterRows(m.dataIndex(m.table.Cursor()))
+}
+
+// Narrow the table rows, keeping the Cursor on the selected Data index
+func (m *Model) filterRows(selected int) {
+	if m.data == nil {
+		return
+	}
+
+	var pattern = m.filter.Value()
+	var _, rows = m.data.GetTableData(m.details)
+	var nameWidth = m.tableColumns()[0].Width
+
+	if len(pattern) == 0 {
+		m.matches = nil
+		if len(m.marked) != 0 {
+			for i, row := range rows {
+				rows[i] = m.markRow(row, i)
+			}
+		}
+		m.table.SetRows(rows)
+		if selected >= 0 {
+			m.table.SetCursor(selected)
+		}
+		return
+	}
+
+	var filtered []table.Row
+	m.matches = []int{}
+
+	for i, row := range rows {
+		positions, ok := fuzzyMatch(pattern, row[0])
+		if !ok {
+			continue
+		}
+		var highlighted = append(table.Row{}, row...)
+		highlighted[0] = highlight(row[0], positions, m.nameWidth(nameWidth, i))
+		filtered = append(filtered, m.markRow(highlighted, i))
+		m.matches = append(m.matches, i)
+	}
+
+	m.table.SetRows(filtered)
+	m.table.SetCursor(0)
+	for row, index := range m.matches {
+		if index == selected {
+			m.table.SetCursor(row)
+		}
+	}
+}
+
+// Remove the filter, keeping the cursor on the same item
+func (m *Model) clearFilter() {
+	var selected = m.dataIndex(m.table.Cursor())
+
+	m.filter.SetValue("")
+	m.filter.Blur()
+	m.filtering = false
+	m.applyFilter()
+
+	if selected >= 0 {
+		m.table.SetCursor(selected)
+	}
+}
+
+func (m Model) filterView() string {
+	if !m.filtering && m.matches == nil {
+		return ""
+	}
+
+	var total = 0
+	if m.data != nil {
+		total = m.data.Len()
+	}
+
+	return filterStyle.Render(m.filter.View()+fmt.Sprintf("  (%d/%d)", len(m.table.Rows()), total)) + "\n"
+}
@@ -0,0 +1,217 @@
+package list
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charan-kumar-137/gsui/gcs"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	searchStyle = lipgloss.NewStyle().Italic(true).Foreground(lipgloss.Color("#3367D6"))
+)
+
+// Objects streamed from a running Glob Search
+type globBatchMsg struct {
+	search  *gcs.GlobSearch
+	objects []*gcs.Object
+	more    bool
+}
+
+func nextGlobBatch(search *gcs.GlobSearch) tea.Cmd {
+	return func() tea.Msg {
+		objects, more := search.Next()
+		return globBatchMsg{search: search, objects: objects, more: more}
+	}
+}
+
+// List the Objects of bucket matching glob and filter, streaming them into the table
+func (m *Model) StartGlobSearch(bucket, glob string, filter *gcs.Filter) tea.Cmd {
+	m.CancelSearch()
+
+	data, err := gcs.NewObjectData(bucket)
+	if err != nil {
+		m.search = nil
+		m.resetSearch(bucket+"/"+glob, nil, err)
+		return nil
+	}
+
+	return m.startSearch(data, gcs.StartGlobSearch(bucket, glob, filter))
+}
+
+// Search the Object Names of every Bucket by prefix or glob
+func (m *Model) StartGlobalSearch(pattern string, filter *gcs.Filter) tea.Cmd {
+	m.CancelSearch()
+
+	return m.startSearch(gcs.NewGlobalData(), gcs.StartGlobalSearch(pattern, filter))
+}
+
+func (m *Model) resetSearch(path string, data *gcs.Data, err error) {
+	m.filter.SetValue("")
+	m.filtering = false
+	m.matches = nil
+	m.clearMarks()
+	m.currentPath = path
+	m.data = data
+	m.offset = 0
+	m.err = err
+	m.searchErr = nil
+
+	if data == nil {
+		m.table.SetRows(nil)
+	}
+}
+
+func (m *Model) startSearch(data *gcs.Data, search *gcs.GlobSearch) tea.Cmd {
+	// Back returns to the Listing the Search started from
+	if entry, recordable := m.departure(); recordable {
+		m.origin = entry
+		m.history.back = push(m.history.back, entry)
+		m.history.forward = nil
+	}
+
+	m.search = search
+	m.searching = true
+	m.resetSearch(m.searchPath(), data, nil)
+
+	m.table = getTable(data, m.details, m.sort, m.width)
+	m.SetDimension(m.width, m.height)
+	if m.focused {
+		m.Focus()
+	}
+
+	return nextGlobBatch(m.search)
+}
+
+// Stop a running Glob Search, keeping the results so far
+func (m *Model) CancelSearch() {
+	if m.search != nil && m.searching {
+		m.search.Cancel()
+		m.searching = false
+	}
+}
+
+func (m *Model) updateGlobBatch(msg globBatchMsg) tea.Cmd {
+	// Results of a cancelled or replaced Search
+	if msg.search != m.search || !m.searching {
+		return nil
+	}
+
+	m.data.AppendObjects(msg.objects)
+	m.applySort()
+
+	if !msg.more {
+		m.searching = false
+		m.searchErr = m.search.Err()
+		return nil
+	}
+
+	return nextGlobBatch(m.search)
+}
+
+// Path shown while listing the Search Results
+func (m Model) searchPath() string {
+	var path = m.search.GetBucket() + "/" + m.search.GetGlob()
+	if m.search.IsGlobal() {
+		path = "*/" + m.search.GetGlob()
+	}
+	if filter := m.search.GetFilter(); filter != nil {
+		path += " | " + filter.String()
+	}
+	return path
+}
+
+// Listing to return to from the Search Results
+func (m Model) searchParent() string {
+	return m.search.GetBucket()
+}
+
+// Whether the table holds the Results of the last Search
+func (m Model) isSearchResult() bool {
+	return m.search != nil && m.currentPath == m.searchPath()
+}
+
+// List the Location of the Result under the Cursor and select it
+func (m *Model) openSearchResult() bool {
+	if !m.isSearchResult() {
+		return false
+	}
+
+	var object = m.GetSelectedRow().GetSelectedObject()
+	if object == nil {
+		return false
+	}
+
+	var path = object.GetBucketName()
+	if index := strings.LastIndex(object.GetName(), "/"); index != -1 {
+		path += "/" + object.GetName()[:index+1]
+	}
+
+	m.UpdateCurrentPath(path)
+	if index := m.objectIndex(object.GetName()); index != -1 {
+		m.position(index, 0)
+	}
+
+	return true
+}
+
+// Row of the Object named name, -1 when not listed
+func (m Model) objectIndex(name string) int {
+	if m.data == nil || m.data.IsBucket {
+		return -1
+	}
+
+	for i := 0; i < m.data.Len(); i++ {
+		if m.data.GetObject(i).GetName() == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Reload the Objects an Action acted on, the marked ones else the selected one
+func (m *Model) reloadSearchResults() {
+	if m.data == nil {
+		return
+	}
+
+	var targets = m.markedIndices()
+	if index := m.dataIndex(m.GetCursor()); len(targets) == 0 && index >= 0 {
+		targets = []int{index}
+	}
+
+	m.err = m.data.ReloadObjects(targets)
+	m.clearMarks()
+	m.applySort()
+}
+
+func (m Model) searchView() string {
+	if !m.isSearchResult() {
+		return ""
+	}
+
+	var mode = "glob " + m.search.GetGlob()
+	if m.search.IsGlobal() {
+		mode = "all buckets " + m.search.GetGlob()
+	}
+	if filter := m.search.GetFilter(); filter != nil {
+		mode += " where " + filter.String()
+	}
+
+	var count = 0
+	if m.data != nil {
+		count = m.data.Len()
+	}
+
+	var state = "done"
+	if m.searching {
+		state = "searching... (esc to cancel)"
+	} else if m.searchErr != nil {
+		state = "failed: " + m.searchErr.Error()
+	}
+
+	return searchStyle.Render(fmt.Sprintf("%s: %d results, %s", mode, count, state)) + "\n"
+}
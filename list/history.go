@@ -0,0 +1,178 @@
+package list
+
+var (
+	// Most Listings kept to go Back to
+	historyLimit = 100
+)
+
+// Visited Listing with its Cursor and first visible row
+type historyEntry struct {
+	path   string
+	cursor int
+	offset int
+}
+
+// Listings to go Back and Forward to, like a browser.
+// Search Results are not kept, they are searched again from the search bar.
+type history struct {
+	back    []historyEntry
+	forward []historyEntry
+}
+
+func push(entries []historyEntry, entry historyEntry) []historyEntry {
+	entries = append(entries, entry)
+	if len(entries) > historyLimit {
+		entries = entries[len(entries)-historyLimit:]
+	}
+	return entries
+}
+
+func pop(entries []historyEntry) ([]historyEntry, historyEntry) {
+	var last = len(entries) - 1
+	return entries[:last], entries[last]
+}
+
+func (m Model) currentEntry() historyEntry {
+	return historyEntry{path: m.currentPath, cursor: m.dataIndex(m.GetCursor()), offset: m.offset}
+}
+
+// Whether the Current Listing can be returned to
+func (m Model) isRecordable() bool {
+	return m.data != nil && !m.isSearchResult()
+}
+
+// Listing to go Back to once left, the one typing started from while previewing
+func (m *Model) departure() (historyEntry, bool) {
+	if m.preview != nil {
+		var entry = *m.preview
+		m.preview = nil
+		return entry, true
+	}
+	return m.currentEntry(), m.isRecordable()
+}
+
+func (m *Model) visit(path string) {
+	var entry, recordable = m.departure()
+
+	m.load(path)
+
+	if recordable && m.err == nil && m.currentPath != entry.path {
+		m.history.back = push(m.history.back, entry)
+		m.history.forward = nil
+	}
+}
+
+// List path as typed in the search bar, without recording each pause in the History
+func (m *Model) Preview(path string) {
+	if m.preview == nil && m.isRecordable() {
+		var entry = m.currentEntry()
+		m.preview = &entry
+	}
+	m.load(path)
+}
+
+// List the previously visited Path, false when there is none
+func (m *Model) Back() bool {
+	if len(m.history.back) == 0 {
+		return false
+	}
+
+	var entry historyEntry
+	m.preview = nil
+	m.history.back, entry = pop(m.history.back)
+	if m.isRecordable() {
+		m.history.forward = push(m.history.forward, m.currentEntry())
+	}
+	m.restore(entry)
+
+	return true
+}
+
+// List the Path left by Back, false when there is none
+func (m *Model) Forward() bool {
+	if len(m.history.forward) == 0 {
+		return false
+	}
+
+	var entry historyEntry
+	m.preview = nil
+	m.history.forward, entry = pop(m.history.forward)
+	if m.isRecordable() {
+		m.history.back = push(m.history.back, m.currentEntry())
+	}
+	m.restore(entry)
+
+	return true
+}
+
+// Path, Cursor and first visible row of the Current Listing.
+// Search Results give the Listing their Search started from.
+func (m Model) GetListing() (string, int, int) {
+	var entry = m.currentEntry()
+	if m.isSearchResult() {
+		entry = m.origin
+	}
+	return entry.path, entry.cursor, entry.offset
+}
+
+// List path at the given position, falling back to the Buckets when it fails.
+// The position is applied once the table has a size.
+func (m *Model) Restore(path string, cursor, offset int) {
+	m.visit(path)
+	if m.err != nil {
+		m.load("")
+		return
+	}
+
+	m.position(cursor, offset)
+}
+
+// Scroll the Current Listing now, or once the table has a size
+func (m *Model) position(cursor, offset int) {
+	if m.height == 0 {
+		m.pending = &historyEntry{path: m.currentPath, cursor: cursor, offset: offset}
+		return
+	}
+	m.scrollTo(cursor, offset)
+}
+
+func (m *Model) restore(entry historyEntry) {
+	m.load(entry.path)
+	if m.err == nil {
+		m.scrollTo(entry.cursor, entry.offset)
+	}
+}
+
+// Keep the first visible row, the table only shows rows around the Cursor
+func (m *Model) trackOffset() {
+	var cursor, height = m.table.Cursor(), m.table.Height()
+
+	if cursor < m.offset {
+		m.offset = cursor
+	}
+	if height > 0 && cursor >= m.offset+height {
+		m.offset = cursor - height + 1
+	}
+}
+
+// Show offset as the first row with the Cursor on cursor, moving as the user would
+// since the table does not expose its scroll position
+func (m *Model) scrollTo(cursor, offset int) {
+	var rows, height = len(m.table.Rows()), m.table.Height()
+
+	if cursor < 0 || cursor >= rows || height <= 0 {
+		return
+	}
+	if offset > cursor || cursor >= offset+height {
+		offset = max(cursor-height+1, 0)
+	}
+
+	m.table.SetCursor(offset + height - 1)
+	m.table.MoveDown(0)
+	for m.table.Cursor() > cursor {
+		m.table.MoveUp(1)
+	}
+
+	m.offset = offset
+	m.trackOffset()
+}
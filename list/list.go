@@ -7,6 +7,7 @@ import (
 	"github.com/charan-kumar-137/gsui/keys"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -19,6 +20,8 @@ type CurrentData struct {
 	path   string
 	data   *gcs.Data
 	cursor int
+	// Data indices of the marked rows
+	marked []int
 }
 
 func (cr CurrentData) GetCurrentData() *gcs.Data {
@@ -33,13 +36,93 @@ func (cr CurrentData) GetPath() string {
 	return cr.path
 }
 
+// Bucket under the Cursor, nil when the Listing is not of Buckets
+func (cr CurrentData) GetSelectedBucket() *gcs.Bucket {
+	if cr.data == nil || !cr.data.IsBucket {
+		return nil
+	}
+	return cr.data.GetBucket(cr.cursor)
+}
+
+// Object under the Cursor, nil when the Listing is not of Objects
+func (cr CurrentData) GetSelectedObject() *gcs.Object {
+	if cr.data == nil || cr.data.IsBucket {
+		return nil
+	}
+	return cr.data.GetObject(cr.cursor)
+}
+
+// Objects marked in the Listing, in listed order
+func (cr CurrentData) GetMarkedObjects() []*gcs.Object {
+	if cr.data == nil || cr.data.IsBucket {
+		return nil
+	}
+
+	var objects []*gcs.Object
+	for _, index := range cr.marked {
+		objects = append(objects, cr.data.GetObject(index))
+	}
+	return objects
+}
+
+// Buckets marked in the Listing, in listed order
+func (cr CurrentData) GetMarkedBuckets() []*gcs.Bucket {
+	if cr.data == nil || !cr.data.IsBucket {
+		return nil
+	}
+
+	var buckets []*gcs.Bucket
+	for _, index := range cr.marked {
+		buckets = append(buckets, cr.data.GetBucket(index))
+	}
+	return buckets
+}
+
+// Marked Objects, else the Object under the Cursor
+func (cr CurrentData) GetTargetObjects() []*gcs.Object {
+	if objects := cr.GetMarkedObjects(); len(objects) != 0 {
+		return objects
+	}
+	if object := cr.GetSelectedObject(); object != nil {
+		return []*gcs.Object{object}
+	}
+	return nil
+}
+
 type Model struct {
 	table       table.Model
 	currentPath string
 	data        *gcs.Data
-	focused     bool
-	width       int
-	height      int
+	details     bool
+	sort        gcs.SortOrder
+	filter      textinput.Model
+	filtering   bool
+	// Data index of each filtered row, nil without a filter
+	matches []int
+	// URIs of the marked rows, anchor is the Data index last toggled
+	marked    map[string]bool
+	anchor    int
+	markInput textinput.Model
+	marking   bool
+	markErr   error
+	// Glob Search streaming into the table
+	search    *gcs.GlobSearch
+	searching bool
+	searchErr error
+	// Visited Listings and the first visible row of the table
+	history history
+	offset  int
+	// Position to restore once the table has a size
+	pending *historyEntry
+	// Listing typing started from, while the search bar previews Paths
+	preview *historyEntry
+	// Listing the Search Results were searched from
+	origin historyEntry
+	// Error of the last Listing
+	err     error
+	focused bool
+	width   int
+	height  int
 }
 
 func getTableKeyMap() table.KeyMap {
@@ -51,10 +134,10 @@ func getTableKeyMap() table.KeyMap {
 	}
 }
 
-func getTable(data *gcs.Data) table.Model {
-	cols, rows := data.GetTableData()
+func getTable(data *gcs.Data, details bool, order gcs.SortOrder, width int) table.Model {
+	cols, rows := data.GetTableData(details)
 	t := table.New(
-		table.WithColumns(cols),
+		table.WithColumns(fitColumns(sortColumns(cols, order), width)),
 		table.WithRows(rows),
 		table.WithKeyMap(getTableKeyMap()),
 		// table.WithFocused(true),
@@ -74,27 +157,102 @@ func getTable(data *gcs.Data) table.Model {
 	return t
 }
 
+func (m Model) GetError() error {
+	return m.err
+}
+
 func (m Model) GetCurrentPath() string {
 	return m.currentPath
 }
 
+// List the path, recording the Current Listing in the History
 func (m *Model) UpdateCurrentPath(path string) {
+	m.visit(path)
+}
+
+func (m *Model) load(path string) {
 
 	var data = gcs.GetData(path)
-	// && data.GetError() != nil
+
+	// A failed Listing, such as of a half typed Bucket, keeps the Current one
+	if data != nil && data.GetError() != nil {
+		m.err = data.GetError()
+		return
+	}
+	m.err = nil
+
+	m.CancelSearch()
+
+	m.filter.SetValue("")
+	m.filter.Blur()
+	m.filtering = false
+	m.matches = nil
+	m.clearMarks()
+
+	if data != nil && len(m.sort.Column) != 0 {
+		data.Sort(m.sort, -1)
+	}
+
 	if data != nil {
 		m.currentPath = path
-		m.table = getTable(data)
+		m.table = getTable(data, m.details, m.sort, m.width)
 	} else {
 		m.table = table.New()
 	}
 
 	m.data = data
+	m.offset = 0
 
+	if m.height != 0 {
+		m.SetDimension(m.width, m.height)
+	}
+	if m.focused {
+		m.Focus()
+	}
+
+}
+
+// List path, or the parent of the Object at path with the Object selected
+func (m *Model) Open(path string) {
+	var bucket, name, _ = strings.Cut(path, "/")
+
+	var isObject = false
+	if len(name) != 0 && !strings.HasSuffix(name, "/") {
+		// Failures to check fall through to listing path, which shows them
+		isObject, _ = gcs.IsObject(bucket, name)
+	}
+
+	if isObject {
+		var parent = bucket
+		if index := strings.LastIndex(name, "/"); index != -1 {
+			parent += "/" + name[:index+1]
+		}
+
+		m.UpdateCurrentPath(parent)
+		if index := m.objectIndex(name); m.err == nil && index != -1 {
+			m.position(index, 0)
+			return
+		}
+	}
+
+	m.UpdateCurrentPath(path)
+}
+
+// Reload the Current Path, keeping the Cursor.
+// Search Results are not searched again, only the marked or selected Objects are reloaded.
+func (m *Model) Refresh() {
+	if m.isSearchResult() {
+		m.reloadSearchResults()
+		return
+	}
+
+	var entry = m.currentEntry()
+	m.load(m.currentPath)
+	m.scrollTo(entry.cursor, entry.offset)
 }
 
 func (m Model) GetSelectedRow() CurrentData {
-	return CurrentData{data: m.GetData(), cursor: m.GetCursor(), path: m.GetCurrentPath()}
+	return CurrentData{data: m.GetData(), cursor: m.dataIndex(m.GetCursor()), path: m.GetCurrentPath(), marked: m.markedIndices()}
 }
 
 func (m Model) GetData() *gcs.Data {
@@ -106,18 +264,22 @@ func (m Model) GetCursor() int {
 }
 
 func (m Model) getSelectedName() string {
+	return m.nameAt(m.dataIndex(m.GetCursor()))
+}
 
-	if m.GetCursor() < 0 || m.data == nil {
+// Name of the Bucket or Object at the Data index
+func (m Model) nameAt(index int) string {
+	if index < 0 || m.data == nil {
 		return ""
 	}
 
 	if m.data.IsBucket {
-		var bucket = m.data.GetBucket(m.GetCursor())
+		var bucket = m.data.GetBucket(index)
 		if bucket != nil {
 			return bucket.GetName()
 		}
 	} else {
-		var object = m.data.GetObject(m.GetCursor())
+		var object = m.data.GetObject(index)
 		if object != nil {
 			return object.GetName()
 		}
@@ -129,7 +291,7 @@ func (m Model) getSelectedName() string {
 func New() Model {
 	var data *gcs.Data = gcs.GetData("")
 
-	return Model{table: getTable(data), data: data}
+	return Model{table: getTable(data, false, gcs.SortOrder{}, 0), data: data, filter: newFilterInput(), markInput: newMarkInput(), anchor: -1}
 }
 
 func (m *Model) Focus() {
@@ -151,6 +313,15 @@ func (m *Model) SetDimension(width, height int) {
 	m.table.SetWidth(width)
 	m.width = width
 	m.height = height
+
+	if m.data != nil {
+		m.table.SetColumns(m.tableColumns())
+	}
+
+	if m.pending != nil && m.pending.path == m.currentPath {
+		m.scrollTo(m.pending.cursor, m.pending.offset)
+	}
+	m.pending = nil
 }
 
 func (m Model) Init() tea.Cmd {
@@ -160,6 +331,11 @@ func (m Model) Init() tea.Cmd {
 
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 
+	// Search Results arrive regardless of focus
+	if msg, ok := msg.(globBatchMsg); ok {
+		return m, m.updateGlobBatch(msg)
+	}
+
 	if !m.GetFocus() {
 		return m, nil
 	}
@@ -168,8 +344,74 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.marking {
+			switch {
+			case key.Matches(msg, keys.Keys.Escape):
+				m.stopMarking()
+			case key.Matches(msg, keys.Keys.Submit):
+				if m.markErr = m.markPattern(m.markInput.Value()); m.markErr == nil {
+					m.stopMarking()
+				}
+			default:
+				m.markInput, cmd = m.markInput.Update(msg)
+				m.markErr = nil
+			}
+			return m, cmd
+		}
+
+		if m.filtering {
+			switch {
+			case key.Matches(msg, keys.Keys.Escape):
+				m.clearFilter()
+			case key.Matches(msg, keys.Keys.Submit):
+				m.filter.Blur()
+				m.filtering = false
+			case key.Matches(msg, keys.Keys.Up), key.Matches(msg, keys.Keys.Down):
+				m.table, cmd = m.table.Update(msg)
+				m.trackOffset()
+			default:
+				m.filter, cmd = m.filter.Update(msg)
+				m.applyFilter()
+			}
+			return m, cmd
+		}
+
 		switch {
+		case key.Matches(msg, keys.Keys.Filter):
+			m.filtering = true
+			return m, m.filter.Focus()
+		case key.Matches(msg, keys.Keys.Mark):
+			m.toggleMark()
+			return m, nil
+		case key.Matches(msg, keys.Keys.MarkRange):
+			m.markRange()
+			return m, nil
+		case key.Matches(msg, keys.Keys.MarkAll):
+			m.markAll()
+			return m, nil
+		case key.Matches(msg, keys.Keys.InvertMarks):
+			m.invertMarks()
+			return m, nil
+		case key.Matches(msg, keys.Keys.MarkPattern):
+			m.marking = true
+			return m, m.markInput.Focus()
+		case key.Matches(msg, keys.Keys.Sort):
+			m.nextSortColumn()
+			return m, nil
+		case key.Matches(msg, keys.Keys.SortOrder):
+			m.reverseSort()
+			return m, nil
+		case key.Matches(msg, keys.Keys.Back):
+			m.Back()
+			return m, nil
+		case key.Matches(msg, keys.Keys.Forward):
+			m.Forward()
+			return m, nil
 		case key.Matches(msg, keys.Keys.Right):
+			if m.openSearchResult() {
+				m.Focus()
+				break
+			}
 			var path = m.currentPath
 			if len(m.currentPath) == 0 {
 				path = m.getSelectedName()
@@ -180,18 +422,31 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			m.Focus()
 		case key.Matches(msg, keys.Keys.Left):
 			var path = m.currentPath
-			if strings.LastIndex(m.currentPath, "/") == -1 {
+			if m.isSearchResult() {
+				path = m.searchParent()
+			} else if strings.LastIndex(m.currentPath, "/") == -1 {
 				path = ""
 			} else {
 				path = m.currentPath[:strings.LastIndex(m.currentPath, "/")]
 			}
 			m.UpdateCurrentPath(path)
 			m.Focus()
+		case key.Matches(msg, keys.Keys.Details):
+			m.details = !m.details
+			if m.data != nil {
+				var cursor = m.GetCursor()
+				m.table = getTable(m.data, m.details, m.sort, m.width)
+				m.applyFilter()
+				m.table.SetCursor(cursor)
+				m.SetDimension(m.width, m.height)
+				m.Focus()
+			}
 		}
 	}
 
 	if m.GetFocus() {
 		m.table, cmd = m.table.Update(msg)
+		m.trackOffset()
 	}
 
 	return m, cmd
@@ -199,5 +454,5 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 
 func (m Model) View() string {
 
-	return lipgloss.NewStyle().Render(m.table.View())
+	return lipgloss.NewStyle().Render(m.searchView() + m.filterView() + m.markView() + m.table.View())
 }
@@ -0,0 +1,231 @@
+package list
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/charan-kumar-137/gsui/gcs"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/mattn/go-runewidth"
+)
+
+var (
+	markPrefix = "● "
+)
+
+func newMarkInput() textinput.Model {
+	ti := textinput.New()
+	ti.Prompt = "mark "
+	ti.Placeholder = "glob, as *.csv or logs/2024-*"
+	return ti
+}
+
+// Whether keys go to the Mark Pattern input
+func (m Model) IsMarking() bool {
+	return m.marking
+}
+
+func (m *Model) stopMarking() {
+	m.marking = false
+	m.markInput.SetValue("")
+	m.markInput.Blur()
+	m.markErr = nil
+}
+
+// Key of a listed Bucket or Object, the URI stays the same across sorting and filtering
+func (m Model) markKey(index int) string {
+	if m.data == nil {
+		return ""
+	}
+	if m.data.IsBucket {
+		if bucket := m.data.GetBucket(index); bucket != nil {
+			return bucket.GetURI()
+		}
+	} else if object := m.data.GetObject(index); object != nil {
+		return object.GetURI()
+	}
+	return ""
+}
+
+func (m Model) isMarked(index int) bool {
+	return m.marked[m.markKey(index)]
+}
+
+func (m *Model) setMark(index int, marked bool) {
+	var key = m.markKey(index)
+	if len(key) == 0 {
+		return
+	}
+	if m.marked == nil {
+		m.marked = make(map[string]bool)
+	}
+	if marked {
+		m.marked[key] = true
+	} else {
+		delete(m.marked, key)
+	}
+}
+
+func (m *Model) clearMarks() {
+	m.marked = nil
+	m.anchor = -1
+	m.stopMarking()
+}
+
+// Data indices of the marked rows, in listed order, filtered out rows included
+func (m Model) markedIndices() []int {
+	if m.data == nil || len(m.marked) == 0 {
+		return nil
+	}
+
+	var indices []int
+	for index := 0; index < m.data.Len(); index++ {
+		if m.isMarked(index) {
+			indices = append(indices, index)
+		}
+	}
+	return indices
+}
+
+// Data indices of the shown rows
+func (m Model) shownIndices() []int {
+	if m.matches != nil {
+		return m.matches
+	}
+
+	var indices = make([]int, len(m.table.Rows()))
+	for i := range indices {
+		indices[i] = i
+	}
+	return indices
+}
+
+// Prefix the Name of a marked row
+func (m Model) markRow(row table.Row, index int) table.Row {
+	if !m.isMarked(index) {
+		return row
+	}
+	var marked = append(table.Row{}, row...)
+	marked[0] = markPrefix + marked[0]
+	return marked
+}
+
+// Width left to the Name of a row once marked
+func (m Model) nameWidth(width, index int) int {
+	if m.isMarked(index) {
+		width -= runewidth.StringWidth(markPrefix)
+	}
+	return width
+}
+
+// Redraw the rows after their marks changed, keeping the scroll position
+func (m *Model) redrawMarks() {
+	var cursor, offset = m.GetCursor(), m.offset
+	m.filterRows(m.dataIndex(cursor))
+	m.scrollTo(cursor, offset)
+}
+
+// Toggle the mark of the row under the Cursor and move to the next row
+func (m *Model) toggleMark() {
+	var index = m.dataIndex(m.GetCursor())
+	if index < 0 || m.data == nil {
+		return
+	}
+
+	m.setMark(index, !m.isMarked(index))
+	m.anchor = index
+	m.redrawMarks()
+	m.table.MoveDown(1)
+	m.trackOffset()
+}
+
+// Mark the rows from the last toggled row to the Cursor
+func (m *Model) markRange() {
+	var cursor = m.GetCursor()
+	var from = cursor
+
+	for row, index := range m.shownIndices() {
+		if index == m.anchor {
+			from = row
+		}
+	}
+
+	for row := min(from, cursor); row <= max(from, cursor); row++ {
+		m.setMark(m.dataIndex(row), true)
+	}
+	m.anchor = m.dataIndex(cursor)
+	m.redrawMarks()
+}
+
+// Mark every shown row, or clear the marks when all of them are marked
+func (m *Model) markAll() {
+	var shown = m.shownIndices()
+
+	var all = true
+	for _, index := range shown {
+		all = all && m.isMarked(index)
+	}
+
+	for _, index := range shown {
+		m.setMark(index, !all)
+	}
+	m.redrawMarks()
+}
+
+func (m *Model) invertMarks() {
+	for _, index := range m.shownIndices() {
+		m.setMark(index, !m.isMarked(index))
+	}
+	m.redrawMarks()
+}
+
+// Mark the shown rows whose Name, or the part after its last /, matches the glob
+func (m *Model) markPattern(pattern string) error {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+
+	for _, index := range m.shownIndices() {
+		var name = m.nameAt(index)
+		full, _ := path.Match(pattern, name)
+		base, _ := path.Match(pattern, path.Base(name))
+		if full || base {
+			m.setMark(index, true)
+		}
+	}
+	m.redrawMarks()
+
+	return nil
+}
+
+func (m Model) markView() string {
+	var view string
+
+	if m.marking {
+		view = m.markInput.View()
+		if m.markErr != nil {
+			view += "  " + m.markErr.Error()
+		}
+		view += "\n"
+	}
+
+	var indices = m.markedIndices()
+	if len(indices) == 0 {
+		if len(view) == 0 {
+			return ""
+		}
+		return filterStyle.Render(view)
+	}
+
+	var status = fmt.Sprintf("%d marked", len(indices))
+	if !m.data.IsBucket {
+		var objects []*gcs.Object
+		for _, index := range indices {
+			objects = append(objects, m.data.GetObject(index))
+		}
+		status += ", total size " + gcs.GetFormat().Size(gcs.TotalSize(objects))
+	}
+
+	return filterStyle.Render(view + status + "\n")
+}
@@ -0,0 +1,79 @@
+package list
+
+import (
+	"slices"
+
+	"github.com/charan-kumar-137/gsui/gcs"
+	"github.com/charmbracelet/bubbles/table"
+)
+
+var (
+	ascendingMark  = " ▲"
+	descendingMark = " ▼"
+)
+
+// Mark the sorted Column in its title
+func sortColumns(cols []table.Column, order gcs.SortOrder) []table.Column {
+	var marked = append([]table.Column{}, cols...)
+
+	for i := range marked {
+		if marked[i].Title != order.Column {
+			continue
+		}
+		if order.Descending {
+			marked[i].Title += descendingMark
+		} else {
+			marked[i].Title += ascendingMark
+		}
+	}
+
+	return marked
+}
+
+func (m Model) GetSort() gcs.SortOrder {
+	return m.sort
+}
+
+// Sort the Current and later Listings
+func (m *Model) SetSort(order gcs.SortOrder) {
+	m.sort = order
+	m.applySort()
+}
+
+// Sort by the next sortable Column of the Listing
+func (m *Model) nextSortColumn() {
+	if m.data == nil {
+		return
+	}
+
+	var columns = m.data.SortColumns(m.details)
+	if len(columns) == 0 {
+		return
+	}
+
+	var next = (slices.Index(columns, m.sort.Column) + 1) % len(columns)
+	m.SetSort(gcs.SortOrder{Column: columns[next], Descending: m.sort.Descending})
+}
+
+func (m *Model) reverseSort() {
+	if len(m.sort.Column) == 0 {
+		return
+	}
+	m.SetSort(gcs.SortOrder{Column: m.sort.Column, Descending: !m.sort.Descending})
+}
+
+// Sort the Data, keeping the Cursor on the same item
+func (m *Model) applySort() {
+	if m.data == nil {
+		return
+	}
+
+	var selected = m.dataIndex(m.GetCursor())
+	if len(m.sort.Column) != 0 {
+		selected = m.data.Sort(m.sort, selected)
+	}
+
+	m.table.SetColumns(m.tableColumns())
+	m.filterRows(selected)
+	m.trackOffset()
+}
@@ -1,17 +1,56 @@
 package main
 
 import (
+	"flag"
+	"fmt"
 	"log"
+	"strings"
 
+	"github.com/charan-kumar-137/gsui/config"
 	"github.com/charan-kumar-137/gsui/display"
 	"github.com/charan-kumar-137/gsui/gcs"
 )
 
 func main() {
+	bookmark := flag.String("bookmark", "", "start at the gs:// location saved under this bookmark name")
+	noSession := flag.Bool("no-session", false, "do not restore the last session, nor save it on quit")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "Usage: gsui [flags] [gs://bucket/prefix/]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	// Flags may also follow the path, as in gsui gs://bucket/ --no-session
+	var args = flag.Args()
+	if len(args) != 0 {
+		flag.CommandLine.Parse(args[1:])
+		args = append([]string{args[0]}, flag.Args()...)
+	}
+
+	if len(args) > 1 || (len(args) == 1 && len(*bookmark) != 0) {
+		flag.Usage()
+		log.Fatalln("expected a single gs:// path or --bookmark")
+	}
+
+	var path string
+	if len(args) == 1 {
+		path = strings.TrimPrefix(args[0], "gs://")
+	}
+	if len(*bookmark) != 0 {
+		saved, found, err := config.FindBookmark(*bookmark)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		if !found {
+			log.Fatalf("bookmark %q not found", *bookmark)
+		}
+		path = saved.Path
+	}
+
 	err := gcs.Init("test")
 
 	if err == nil {
-		display.Run()
+		display.Run(display.Options{Path: path, Session: !*noSession})
 	} else {
 		log.Fatalln(err)
 	}
@@ -0,0 +1,163 @@
+package search
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charan-kumar-137/gsui/gcs"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	// Most Suggestions shown in the dropdown
+	maxDropdown = 8
+
+	ghostStyle    = lipgloss.NewStyle().Faint(true)
+	selectedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFFFF")).Background(lipgloss.Color("#3367D6"))
+	errorStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("#D93025"))
+)
+
+// Full paths completing path: Bucket Names, then Objects and Prefixes under the typed Prefix
+func completions(path string) ([]string, error) {
+	var bucket, prefix, found = strings.Cut(path, "/")
+
+	if !found {
+		names, err := gcs.ListChildren("", "")
+		return withPrefix(names, "", bucket, "/"), err
+	}
+
+	// List the Prefix up to its last "/", completing the remainder
+	var parent = prefix[:strings.LastIndex(prefix, "/")+1]
+	names, err := gcs.ListChildren(bucket, parent)
+
+	return withPrefix(names, bucket+"/", prefix, ""), err
+}
+
+// Names starting with typed, prepended with base and appended with suffix
+func withPrefix(names []string, base, typed, suffix string) []string {
+	var matches []string
+	for _, name := range names {
+		if strings.HasPrefix(name, typed) {
+			matches = append(matches, base+name+suffix)
+		}
+	}
+	return matches
+}
+
+func commonPrefix(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	var prefix = values[0]
+	for _, value := range values[1:] {
+		// Trimmed by rune, so multibyte names are never cut in half
+		for !strings.HasPrefix(value, prefix) {
+			_, size := utf8.DecodeLastRuneInString(prefix)
+			prefix = prefix[:len(prefix)-size]
+		}
+	}
+	return prefix
+}
+
+// Completions of the Path, stale if more was typed since
+type completedMsg struct {
+	tag         int
+	suggestions []string
+	err         error
+}
+
+// Complete the Path off the update loop, cycling the offered matches on repeated presses
+func (m *Model) complete() tea.Cmd {
+	if len(m.suggestions) != 0 && m.GetCurrentPath() == m.completedPath {
+		m.selected = (m.selected + 1) % len(m.suggestions)
+		return nil
+	}
+
+	var path, tag = m.GetCurrentPath(), m.tag
+	return func() tea.Msg {
+		suggestions, err := completions(path)
+		return completedMsg{tag: tag, suggestions: suggestions, err: err}
+	}
+}
+
+// A single match is accepted, otherwise the common prefix is typed and the matches are offered
+func (m *Model) applyCompletions(suggestions []string, err error) {
+	m.completionErr = err
+	m.suggestions = nil
+	m.selected = 0
+
+	switch len(suggestions) {
+	case 0:
+		return
+	case 1:
+		m.setPath(suggestions[0])
+	default:
+		var prefix = commonPrefix(suggestions)
+		if len(prefix) > len(m.GetCurrentPath()) {
+			m.setPath(prefix)
+		}
+		m.suggestions = suggestions
+		m.completedPath = m.GetCurrentPath()
+	}
+}
+
+// Accept the selected Suggestion
+func (m *Model) acceptSuggestion() {
+	m.setPath(m.suggestions[m.selected])
+	m.clearSuggestions()
+}
+
+func (m *Model) clearSuggestions() {
+	m.suggestions = nil
+	m.completedPath = ""
+	m.completionErr = nil
+}
+
+func (m *Model) setPath(path string) {
+	m.UpdateCurrentPath(path)
+	m.text.CursorEnd()
+	m.tag += 1
+}
+
+// Rest of the selected Suggestion, shown after the typed Path
+func (m Model) ghostView() string {
+	if len(m.suggestions) == 0 {
+		return ""
+	}
+	return ghostStyle.Render(strings.TrimPrefix(m.suggestions[m.selected], m.GetCurrentPath()))
+}
+
+func (m Model) dropdownView() string {
+	if m.completionErr != nil {
+		return "\n" + errorStyle.Render(m.completionErr.Error())
+	}
+
+	if len(m.suggestions) == 0 {
+		return ""
+	}
+
+	// Keep the selected Suggestion visible
+	var start = 0
+	if m.selected >= maxDropdown {
+		start = m.selected - maxDropdown + 1
+	}
+	var end = min(start+maxDropdown, len(m.suggestions))
+
+	var sb strings.Builder
+	for i := start; i < end; i++ {
+		sb.WriteString("\n")
+		if i == m.selected {
+			sb.WriteString(selectedStyle.Render("  " + m.suggestions[i]))
+		} else {
+			sb.WriteString("  " + m.suggestions[i])
+		}
+	}
+	if len(m.suggestions) > maxDropdown {
+		sb.WriteString(ghostStyle.Render(fmt.Sprintf("\n  %d of %d, keep pressing to cycle", m.selected+1, len(m.suggestions))))
+	}
+
+	return sb.String()
+}
@@ -1,24 +1,99 @@
 package search
 
 import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/charan-kumar-137/gsui/gcs"
+	"github.com/charan-kumar-137/gsui/keys"
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
+var (
+	pathPrompt = "gs://"
+	globPrompt = "glob gs://"
+	// Object names of every Bucket are searched
+	globalPrompt = "all gs://"
+	globalPrefix = "*/"
+	filterPrompt = "filter gs://"
+	// Separates the Path from a Filter expression, as in bucket/2024/ | size > 1GiB
+	filterSeparator = "|"
+
+	globMetaCharacters = "*?[{"
+
+	// Pause in typing after which the Path is navigated to
+	debounceInterval = 500 * time.Millisecond
+)
+
+// Sent on enter or once typing paused, to list the Path
+type NavigateMsg struct {
+	Path string
+	// Sent once typing paused, the Path is only previewed
+	Typed bool
+}
+
+// Fires after the debounce interval, stale if more was typed since
+type debounceMsg struct {
+	tag int
+}
+
+// Sent on enter when the Path is a Glob or has a Filter, to be searched with MatchGlob.
+// Glob is a plain prefix when it has no metacharacters.
+type GlobMsg struct {
+	Bucket string
+	Glob   string
+	Filter *gcs.Filter
+}
+
+// Sent on enter when the Path starts with "*/", to search every Bucket
+type GlobalSearchMsg struct {
+	Pattern string
+	Filter  *gcs.Filter
+}
+
 type Model struct {
-	text    textinput.Model
+	text textinput.Model
+	// Completion of the Path
+	suggestions   []string
+	selected      int
+	completedPath string
+	completionErr error
+	// Incremented on every edit, to debounce navigation
+	tag int
+	// Inline error of the last navigation
+	err     error
 	focused bool
 	width   int
 	height  int
 }
 
+// Whether the Object part of the path contains Glob metacharacters
+func IsGlob(path string) bool {
+	var index = strings.Index(path, "/")
+	return index != -1 && strings.ContainsAny(path[index+1:], globMetaCharacters)
+}
+
+// Whether the path searches every Bucket, as in */logs/2024-*
+func IsGlobal(path string) bool {
+	return strings.HasPrefix(path, globalPrefix)
+}
+
+// Split the Path from its Filter expression, if any
+func SplitFilter(path string) (string, string, bool) {
+	before, after, found := strings.Cut(path, filterSeparator)
+	return strings.TrimSpace(before), strings.TrimSpace(after), found
+}
+
 func New() Model {
 	ti := textinput.New()
 
-	ti.Prompt = "gs://"
+	ti.Prompt = pathPrompt
 
-	ti.Placeholder = "<bucket>/<object>"
+	ti.Placeholder = "<bucket>/<object>, <bucket>/**/*.parquet, */<prefix> or <bucket> | size > 1GiB"
 	ti.PlaceholderStyle = lipgloss.NewStyle()
 
 	return Model{text: ti, focused: false}
@@ -31,7 +106,10 @@ func (m *Model) Focus() {
 
 func (m *Model) Blur() {
 	m.text.Blur()
+	m.clearSuggestions()
 	m.focused = false
+	// Pending debounces no longer navigate once the user moved on
+	m.tag += 1
 }
 
 func (m Model) GetFocus() bool {
@@ -50,22 +128,153 @@ func (m Model) GetCurrentPath() string {
 
 func (m *Model) UpdateCurrentPath(path string) {
 	m.text.SetValue(path)
+	m.updatePrompt()
+}
+
+// Show whether the Path is searched as a Glob
+func (m *Model) updatePrompt() {
+	var _, _, filtered = SplitFilter(m.GetCurrentPath())
+
+	if filtered {
+		m.text.Prompt = filterPrompt
+	} else if IsGlobal(m.GetCurrentPath()) {
+		m.text.Prompt = globalPrompt
+	} else if IsGlob(m.GetCurrentPath()) {
+		m.text.Prompt = globPrompt
+	} else {
+		m.text.Prompt = pathPrompt
+	}
 }
 
 func (m Model) Init() tea.Cmd {
 	return nil
 }
 
+func navigate(path string) tea.Cmd {
+	return func() tea.Msg {
+		return NavigateMsg{Path: path}
+	}
+}
+
+// Show an inline error for the last navigation, nil clears it
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
+// Whether the path is searched rather than listed
+func IsSearch(path string) bool {
+	var trimmed, _, filtered = SplitFilter(path)
+	return filtered || IsGlob(trimmed) || IsGlobal(trimmed)
+}
+
+// Whether enter searches rather than lists the Path
+func (m Model) isSearch() bool {
+	return IsSearch(m.GetCurrentPath())
+}
+
+// Search by Glob, across Buckets or by Filter, showing an invalid Filter inline
+func (m *Model) submitSearch() tea.Cmd {
+	var path, expr, filtered = SplitFilter(m.GetCurrentPath())
+
+	var filter *gcs.Filter
+	if filtered {
+		var err error
+		if filter, err = gcs.ParseFilter(expr); err != nil {
+			m.err = err
+			return nil
+		}
+	}
+	// A bare Filter would scan every Object of the Project
+	if len(path) == 0 {
+		m.err = errors.New("filter needs a bucket, or */ to search every bucket")
+		return nil
+	}
+	m.err = nil
+
+	if IsGlobal(path) {
+		var pattern = strings.TrimPrefix(path, globalPrefix)
+		return func() tea.Msg {
+			return GlobalSearchMsg{Pattern: pattern, Filter: filter}
+		}
+	}
+
+	var bucket, glob, _ = strings.Cut(path, "/")
+	return func() tea.Msg {
+		return GlobMsg{Bucket: bucket, Glob: glob, Filter: filter}
+	}
+}
+
+// Search or list the Current Path, as on enter
+func (m *Model) Submit() tea.Cmd {
+	m.tag += 1
+	if m.isSearch() {
+		return m.submitSearch()
+	}
+	return navigate(m.GetCurrentPath())
+}
+
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	var cmd tea.Cmd
 
-	if m.GetFocus() {
-		m.text, cmd = m.text.Update(msg)
+	if msg, ok := msg.(debounceMsg); ok {
+		if msg.tag != m.tag || m.isSearch() {
+			return m, nil
+		}
+		var path = m.GetCurrentPath()
+		return m, func() tea.Msg {
+			return NavigateMsg{Path: path, Typed: true}
+		}
+	}
+
+	if msg, ok := msg.(completedMsg); ok {
+		if msg.tag == m.tag && m.GetFocus() {
+			m.applyCompletions(msg.suggestions, msg.err)
+		}
+		return m, nil
+	}
+
+	if !m.GetFocus() {
+		return m, nil
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, keys.Keys.Complete) && m.isSearch():
+			return m, nil
+		case key.Matches(msg, keys.Keys.Complete):
+			return m, m.complete()
+		case key.Matches(msg, keys.Keys.Submit) && len(m.suggestions) != 0:
+			m.acceptSuggestion()
+			return m, nil
+		case key.Matches(msg, keys.Keys.Submit):
+			return m, m.Submit()
+		}
+		m.clearSuggestions()
+	}
+
+	var path = m.GetCurrentPath()
+
+	m.text, cmd = m.text.Update(msg)
+	m.updatePrompt()
+
+	// Navigate once typing paused
+	if m.GetCurrentPath() != path {
+		m.tag += 1
+		var tag = m.tag
+		cmd = tea.Batch(cmd, tea.Tick(debounceInterval, func(time.Time) tea.Msg {
+			return debounceMsg{tag: tag}
+		}))
 	}
 
 	return m, cmd
 }
 
 func (m Model) View() string {
-	return lipgloss.NewStyle().Render(m.text.View())
+	var view = m.text.View() + m.ghostView() + m.dropdownView()
+
+	if m.err != nil && len(m.suggestions) == 0 {
+		view += "\n" + errorStyle.Render(m.err.Error())
+	}
+
+	return lipgloss.NewStyle().Render(view)
 }